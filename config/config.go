@@ -3,7 +3,7 @@ package config
 import (
 	"github.com/kelseyhightower/envconfig"
 	"github.com/paysuper/paysuper-currencies/internal/currency"
-	"github.com/paysuper/paysuper-proto/go/currenciespb"
+	currenciespb "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 )
 
 // Config is struct for store service configuration
@@ -12,18 +12,58 @@ type Config struct {
 	MongoDialTimeout string `envconfig:"MONGO_DIAL_TIMEOUT" required:"false" default:"10"`
 
 	MetricsPort int `envconfig:"METRICS_PORT" required:"false" default:"80"`
+	RestPort    int `envconfig:"REST_PORT" required:"false" default:"0"`
 
-	CentrifugoSecret  string `envconfig:"CENTRIFUGO_SECRET" required:"true"`
-	CentrifugoURL     string `envconfig:"CENTRIFUGO_URL" required:"false" default:"http://127.0.0.1:8000"`
-	CentrifugoChannel string `envconfig:"CENTRIFUGO_CHANNEL" default:"paysuper:admin"`
+	CentrifugoSecret       string `envconfig:"CENTRIFUGO_SECRET" required:"true"`
+	CentrifugoURL          string `envconfig:"CENTRIFUGO_URL" required:"false" default:"http://127.0.0.1:8000"`
+	CentrifugoChannel      string `envconfig:"CENTRIFUGO_CHANNEL" default:"paysuper:admin"`
+	CentrifugoRatesChannel string `envconfig:"CENTRIFUGO_RATES_CHANNEL" default:"paysuper:currency_rates"`
 
 	OxrAppId string `envconfig:"OXR_APP_ID" required:"true"`
 
+	CrossRatesPivots []string `envconfig:"CROSS_RATES_PIVOTS" default:"USD,EUR"`
+
+	BrokerAddress       string `envconfig:"BROKER_ADDRESS" default:"amqp://127.0.0.1:5672"`
+	CardpayEnabled      bool   `envconfig:"CARDPAY_ENABLED" default:"false"`
+	RatesUpdatesEnabled bool   `envconfig:"RATES_UPDATES_ENABLED" default:"false"`
+
+	SchedulerEnabled       bool  `envconfig:"SCHEDULER_ENABLED" default:"false"`
+	SchedulerCheckInterval int64 `envconfig:"SCHEDULER_CHECK_INTERVAL" default:"60"`
+	SchedulerLockTimeout   int64 `envconfig:"SCHEDULER_LOCK_TIMEOUT" default:"3600"`
+
+	ExchangeRoundingMode string `envconfig:"EXCHANGE_ROUNDING_MODE" default:"half_even"`
+
+	CurrenciesReloadInterval int64 `envconfig:"CURRENCIES_RELOAD_INTERVAL" default:"60"`
+
+	PaysuperForecastWindow           int64   `envconfig:"PAYSUPER_FORECAST_WINDOW" default:"7"`
+	PaysuperForecastVolatilityFactor float64 `envconfig:"PAYSUPER_FORECAST_VOLATILITY_FACTOR" default:"1"`
+
+	PaysuperCorridor       float64 `envconfig:"PAYSUPER_CORRIDOR" default:"0"`
+	PaysuperCorridorAction string  `envconfig:"PAYSUPER_CORRIDOR_ACTION" default:"clamp"`
+
+	StockBidSpread        float64 `envconfig:"STOCK_BID_SPREAD" default:"0"`
+	StockAskSpread        float64 `envconfig:"STOCK_ASK_SPREAD" default:"0"`
+	StockImportPath       string  `envconfig:"STOCK_IMPORT_PATH" default:""`
+	StockExecutionsMaxAge int64   `envconfig:"STOCK_EXECUTIONS_MAX_AGE" default:"24"`
+
+	AnomalyThreshold      float64            `envconfig:"ANOMALY_THRESHOLD" default:"10"`
+	AnomalyPairThresholds map[string]float64 `envconfig:"ANOMALY_PAIR_THRESHOLDS" default:""`
+	AnomalyAction         string             `envconfig:"ANOMALY_ACTION" default:"quarantine"`
+
+	BatchMaxItems int `envconfig:"BATCH_MAX_ITEMS" default:"5000"`
+
+	HistoryMaxItems int `envconfig:"HISTORY_MAX_ITEMS" default:"10000"`
+
+	CacheTtl                   int64 `envconfig:"CACHE_TTL" default:"60"`
+	CacheSize                  int   `envconfig:"CACHE_SIZE" default:"10000"`
+	CacheVersionsCheckInterval int64 `envconfig:"CACHE_VERSIONS_CHECK_INTERVAL" default:"1"`
+
 	RatesTypes map[string]bool
 
 	Currencies map[string]currency.CurrencyProperties
 
-	CurrenciesPrecision map[string]int32
+	CurrenciesPrecision  map[string]int32
+	CurrenciesMinorUnits map[string]int32
 
 	SettlementCurrencies   []string
 	PriceCurrencies        []string
@@ -53,14 +93,30 @@ func NewConfig() (*Config, error) {
 	cfg.RatesTypes[currenciespb.RateTypeStock] = true
 	cfg.RatesTypes[currenciespb.RateTypeCardpay] = true
 
-	cfg.Currencies = currency.CurrencyDefinitions
+	cfg.SetCurrencies(currency.CurrencyDefinitions)
+
+	return cfg, err
+}
+
+// SetCurrencies - sets currencies definitions and rebuilds the currencies lists derived from them
+func (cfg *Config) SetCurrencies(definitions map[string]currency.CurrencyProperties) {
+	cfg.Currencies = definitions
 	cfg.SupportedCurrenciesParsed = make(map[string]bool, len(cfg.Currencies))
 	cfg.CurrenciesPrecision = make(map[string]int32, len(cfg.Currencies))
+	cfg.CurrenciesMinorUnits = make(map[string]int32, len(cfg.Currencies))
+
+	cfg.SupportedCurrencies = nil
+	cfg.RatesRequestCurrencies = nil
+	cfg.PriceCurrencies = nil
+	cfg.VatCurrencies = nil
+	cfg.SettlementCurrencies = nil
+	cfg.AccountingCurrencies = nil
 
 	for code, properties := range cfg.Currencies {
 		cfg.SupportedCurrencies = append(cfg.SupportedCurrencies, code)
 		cfg.SupportedCurrenciesParsed[code] = true
 		cfg.CurrenciesPrecision[code] = properties.Precision
+		cfg.CurrenciesMinorUnits[code] = properties.MinorUnits
 
 		if properties.Price || properties.Vat || properties.Local {
 			cfg.RatesRequestCurrencies = append(cfg.RatesRequestCurrencies, code)
@@ -99,6 +155,4 @@ func NewConfig() (*Config, error) {
 			cfg.OxrRatesDirectPairs[from+to] = true
 		}
 	}
-
-	return cfg, err
 }
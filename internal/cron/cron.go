@@ -0,0 +1,183 @@
+package cron
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	errorInvalidFieldsCount = "cron expression must contain 5 fields"
+	errorInvalidField       = "cron expression field invalid"
+	errorInvalidRange       = "cron expression range invalid"
+	errorInvalidStep        = "cron expression step invalid"
+
+	// next run search is limited to prevent infinite loop on expressions like "0 0 30 2 *"
+	searchYearsLimit = 5
+)
+
+type bounds struct {
+	min int
+	max int
+}
+
+var (
+	minuteBounds = bounds{0, 59}
+	hourBounds   = bounds{0, 23}
+	domBounds    = bounds{1, 31}
+	monthBounds  = bounds{1, 12}
+	dowBounds    = bounds{0, 7}
+)
+
+// Schedule - parsed cron expression in standard 5-fields format: minute, hour, day of month, month, day of week
+type Schedule struct {
+	minute map[int]bool
+	hour   map[int]bool
+	dom    map[int]bool
+	month  map[int]bool
+	dow    map[int]bool
+
+	domRestricted bool
+	dowRestricted bool
+}
+
+// Parse - returns schedule for passed cron expression
+func Parse(expr string) (*Schedule, error) {
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, errors.New(errorInvalidFieldsCount)
+	}
+
+	var err error
+	s := &Schedule{
+		domRestricted: fields[2] != "*",
+		dowRestricted: fields[4] != "*",
+	}
+
+	if s.minute, err = parseField(fields[0], minuteBounds); err != nil {
+		return nil, err
+	}
+	if s.hour, err = parseField(fields[1], hourBounds); err != nil {
+		return nil, err
+	}
+	if s.dom, err = parseField(fields[2], domBounds); err != nil {
+		return nil, err
+	}
+	if s.month, err = parseField(fields[3], monthBounds); err != nil {
+		return nil, err
+	}
+	if s.dow, err = parseField(fields[4], dowBounds); err != nil {
+		return nil, err
+	}
+	// 7 is allowed as an alias of sunday
+	if s.dow[7] {
+		s.dow[0] = true
+	}
+
+	return s, nil
+}
+
+// Next - returns the nearest time after passed one that matches the schedule,
+// or zero time if there is no such time in the nearest years
+func (s *Schedule) Next(t time.Time) time.Time {
+	t = t.Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(searchYearsLimit, 0, 0)
+
+	for t.Before(limit) {
+		if !s.month[int(t.Month())] {
+			t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).AddDate(0, 1, 0)
+			continue
+		}
+
+		if !s.isDayMatch(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).AddDate(0, 0, 1)
+			continue
+		}
+
+		if !s.hour[t.Hour()] {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location()).Add(time.Hour)
+			continue
+		}
+
+		if !s.minute[t.Minute()] {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *Schedule) isDayMatch(t time.Time) bool {
+	domMatch := s.dom[t.Day()]
+	dowMatch := s.dow[int(t.Weekday())]
+
+	// the same as in the classic cron, if both day fields are restricted, a day should match any of them
+	if s.domRestricted && s.dowRestricted {
+		return domMatch || dowMatch
+	}
+
+	return domMatch && dowMatch
+}
+
+func parseField(field string, b bounds) (map[int]bool, error) {
+	values := make(map[int]bool)
+
+	for _, part := range strings.Split(field, ",") {
+		if err := parsePart(part, b, values); err != nil {
+			return nil, fmt.Errorf("%s: %s", err.Error(), field)
+		}
+	}
+
+	return values, nil
+}
+
+func parsePart(part string, b bounds, values map[int]bool) error {
+	var err error
+
+	step := 1
+	if i := strings.Index(part, "/"); i >= 0 {
+		step, err = strconv.Atoi(part[i+1:])
+		if err != nil || step <= 0 {
+			return errors.New(errorInvalidStep)
+		}
+		part = part[:i]
+	}
+
+	from, to := b.min, b.max
+
+	switch {
+	case part == "*":
+	case strings.Contains(part, "-"):
+		r := strings.SplitN(part, "-", 2)
+		if from, err = strconv.Atoi(r[0]); err != nil {
+			return errors.New(errorInvalidField)
+		}
+		if to, err = strconv.Atoi(r[1]); err != nil {
+			return errors.New(errorInvalidField)
+		}
+	default:
+		if from, err = strconv.Atoi(part); err != nil {
+			return errors.New(errorInvalidField)
+		}
+		to = from
+		// "n/step" means from n up to the end of range
+		if step > 1 {
+			to = b.max
+		}
+	}
+
+	if from < b.min || to > b.max || from > to {
+		return errors.New(errorInvalidRange)
+	}
+
+	for v := from; v <= to; v += step {
+		values[v] = true
+	}
+
+	return nil
+}
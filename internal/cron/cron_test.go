@@ -0,0 +1,54 @@
+package cron
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+	"time"
+)
+
+type CronTestSuite struct {
+	suite.Suite
+	now time.Time
+}
+
+func Test_Cron(t *testing.T) {
+	suite.Run(t, new(CronTestSuite))
+}
+
+func (suite *CronTestSuite) SetupTest() {
+	suite.now = time.Date(2020, 6, 23, 3, 7, 30, 0, time.UTC)
+}
+
+func (suite *CronTestSuite) Test_Next_Ok() {
+	cases := map[string]time.Time{
+		"10 3 * * *":   time.Date(2020, 6, 23, 3, 10, 0, 0, time.UTC),
+		"5 3 * * *":    time.Date(2020, 6, 24, 3, 5, 0, 0, time.UTC),
+		"*/15 * * * *": time.Date(2020, 6, 23, 3, 15, 0, 0, time.UTC),
+		"30 2/6 * * *": time.Date(2020, 6, 23, 8, 30, 0, 0, time.UTC),
+		"0 0 1 * *":    time.Date(2020, 7, 1, 0, 0, 0, 0, time.UTC),
+		"0 12 * * 1-5": time.Date(2020, 6, 23, 12, 0, 0, 0, time.UTC),
+		"0 12 * * 7":   time.Date(2020, 6, 28, 12, 0, 0, 0, time.UTC),
+		"0 0 13 * 5":   time.Date(2020, 6, 26, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":   time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+	}
+
+	for expr, expected := range cases {
+		s, err := Parse(expr)
+		assert.NoError(suite.T(), err, expr)
+		assert.Equal(suite.T(), expected, s.Next(suite.now), expr)
+	}
+}
+
+func (suite *CronTestSuite) Test_Next_NotFound() {
+	s, err := Parse("0 0 30 2 *")
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), s.Next(suite.now).IsZero())
+}
+
+func (suite *CronTestSuite) Test_Parse_Fail() {
+	for _, expr := range []string{"", "* * * *", "* * * * * *", "60 * * * *", "a * * * *", "*/0 * * * *", "5-1 * * * *"} {
+		_, err := Parse(expr)
+		assert.Error(suite.T(), err, expr)
+	}
+}
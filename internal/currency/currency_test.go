@@ -26,3 +26,13 @@ func (suite *CurrenciesTestSuite) SetupTest() {
 func (suite *CurrenciesTestSuite) Test_Currencies() {
 	assert.Equal(suite.T(), len(CurrencyDefinitions), 55)
 }
+
+func (suite *CurrenciesTestSuite) Test_MinorUnits() {
+	for code, properties := range CurrencyDefinitions {
+		assert.True(suite.T(), properties.MinorUnits >= properties.Precision, code)
+	}
+	assert.EqualValues(suite.T(), CurrencyDefinitions["BHD"].MinorUnits, 3)
+	assert.EqualValues(suite.T(), CurrencyDefinitions["BHD"].Precision, 2)
+	assert.EqualValues(suite.T(), CurrencyDefinitions["KWD"].MinorUnits, 3)
+	assert.EqualValues(suite.T(), CurrencyDefinitions["JPY"].MinorUnits, 0)
+}
@@ -0,0 +1,154 @@
+package decimal
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+)
+
+const (
+	// RoundHalfEven - rounds to the nearest neighbour, ties are rounded to the even neighbour (banker's rounding)
+	RoundHalfEven = "half_even"
+	// RoundHalfUp - rounds to the nearest neighbour, ties are rounded away from zero
+	RoundHalfUp = "half_up"
+	// RoundCeil - rounds towards positive infinity
+	RoundCeil = "ceil"
+	// RoundFloor - rounds towards negative infinity
+	RoundFloor = "floor"
+
+	errorInvalidValue        = "decimal value invalid"
+	errorInvalidRoundingMode = "rounding mode invalid"
+
+	// float64 keeps 15 significant decimal digits exactly, the rest digits are binary representation noise
+	floatSignificantDigits = 15
+)
+
+// RoundingModes - list of supported rounding modes
+var RoundingModes = map[string]bool{
+	RoundHalfEven: true,
+	RoundHalfUp:   true,
+	RoundCeil:     true,
+	RoundFloor:    true,
+}
+
+// Decimal - arbitrary precision fixed-point decimal number
+type Decimal struct {
+	value *big.Rat
+}
+
+// NewFromFloat - returns decimal for passed float value, the value is cut to significant digits of float64
+func NewFromFloat(f float64) Decimal {
+	d, err := NewFromString(strconv.FormatFloat(f, 'g', floatSignificantDigits, 64))
+	if err != nil {
+		// infinities and NaN have no decimal representation
+		return Zero()
+	}
+	return d
+}
+
+// NewFromString - returns decimal for passed string, like "64.6314"
+func NewFromString(s string) (Decimal, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Zero(), errors.New(errorInvalidValue)
+	}
+	return Decimal{value: r}, nil
+}
+
+// NewFromInt - returns decimal for passed integer value
+func NewFromInt(i int64) Decimal {
+	return Decimal{value: new(big.Rat).SetInt64(i)}
+}
+
+// Zero - returns zero decimal
+func Zero() Decimal {
+	return Decimal{value: new(big.Rat)}
+}
+
+// ValidateRoundingMode - returns error if rounding mode is not supported
+func ValidateRoundingMode(mode string) error {
+	if !RoundingModes[mode] {
+		return errors.New(errorInvalidRoundingMode)
+	}
+	return nil
+}
+
+func (d Decimal) rat() *big.Rat {
+	if d.value == nil {
+		return new(big.Rat)
+	}
+	return d.value
+}
+
+// Add - returns d + o
+func (d Decimal) Add(o Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Add(d.rat(), o.rat())}
+}
+
+// Sub - returns d - o
+func (d Decimal) Sub(o Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Sub(d.rat(), o.rat())}
+}
+
+// Mul - returns d * o
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Mul(d.rat(), o.rat())}
+}
+
+// Div - returns d / o, division by zero panics as big.Rat does, so the caller must check the divisor
+func (d Decimal) Div(o Decimal) Decimal {
+	return Decimal{value: new(big.Rat).Quo(d.rat(), o.rat())}
+}
+
+// Cmp - compares decimals, returns -1 if d < o, 0 if d == o and +1 if d > o
+func (d Decimal) Cmp(o Decimal) int {
+	return d.rat().Cmp(o.rat())
+}
+
+// IsZero - returns true for zero value
+func (d Decimal) IsZero() bool {
+	return d.rat().Sign() == 0
+}
+
+// Round - returns decimal rounded to passed number of digits after the decimal point with passed rounding mode,
+// unknown rounding mode is processed as half-even
+func (d Decimal) Round(places int32, mode string) Decimal {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil)
+	scaled := new(big.Rat).Mul(d.rat(), new(big.Rat).SetInt(scale))
+
+	// euclidean division, so quo is the floor of the scaled value and rem is non-negative
+	quo, rem := new(big.Int).DivMod(scaled.Num(), scaled.Denom(), new(big.Int))
+
+	if rem.Sign() != 0 {
+		// compare fractional part with one half: rem / denom <=> 1 / 2
+		half := new(big.Int).Lsh(rem, 1).Cmp(scaled.Denom())
+
+		roundUp := false
+		switch mode {
+		case RoundFloor:
+		case RoundCeil:
+			roundUp = true
+		case RoundHalfUp:
+			roundUp = half > 0 || (half == 0 && scaled.Sign() > 0)
+		default:
+			roundUp = half > 0 || (half == 0 && quo.Bit(0) == 1)
+		}
+
+		if roundUp {
+			quo.Add(quo, big.NewInt(1))
+		}
+	}
+
+	return Decimal{value: new(big.Rat).SetFrac(quo, scale)}
+}
+
+// Float64 - returns the nearest float value for decimal
+func (d Decimal) Float64() float64 {
+	f, _ := d.rat().Float64()
+	return f
+}
+
+// StringFixed - returns decimal as a string with passed number of digits after the decimal point
+func (d Decimal) StringFixed(places int32) string {
+	return d.Round(places, RoundHalfEven).rat().FloatString(int(places))
+}
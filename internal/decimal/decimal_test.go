@@ -0,0 +1,76 @@
+package decimal
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"testing"
+)
+
+type DecimalTestSuite struct {
+	suite.Suite
+}
+
+func Test_Decimal(t *testing.T) {
+	suite.Run(t, new(DecimalTestSuite))
+}
+
+func (suite *DecimalTestSuite) Test_Round_Ok() {
+	cases := []struct {
+		value    string
+		places   int32
+		mode     string
+		expected string
+	}{
+		{"2.5", 0, RoundHalfEven, "2"},
+		{"3.5", 0, RoundHalfEven, "4"},
+		{"-2.5", 0, RoundHalfEven, "-2"},
+		{"2.345", 2, RoundHalfEven, "2.34"},
+		{"2.5", 0, RoundHalfUp, "3"},
+		{"-2.5", 0, RoundHalfUp, "-3"},
+		{"-2.4", 0, RoundHalfUp, "-2"},
+		{"1.001", 2, RoundCeil, "1.01"},
+		{"-1.001", 2, RoundCeil, "-1.00"},
+		{"1.009", 2, RoundFloor, "1.00"},
+		{"-1.001", 2, RoundFloor, "-1.01"},
+		{"64.6314", 6, RoundCeil, "64.631400"},
+		{"0.1234", 3, "bla-bla", "0.123"},
+	}
+
+	for _, c := range cases {
+		d, err := NewFromString(c.value)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), c.expected, d.Round(c.places, c.mode).StringFixed(c.places), c.value+" "+c.mode)
+	}
+}
+
+func (suite *DecimalTestSuite) Test_NewFromFloat_Ok() {
+	// 0.17 * 0.07 = 0.011900000000000003 in float64
+	assert.Equal(suite.T(), 0.0119, NewFromFloat(0.17*0.07).Round(6, RoundCeil).Float64())
+	assert.Equal(suite.T(), "6463.14", NewFromFloat(64.6314).Mul(NewFromInt(100)).StringFixed(2))
+}
+
+func (suite *DecimalTestSuite) Test_Arithmetic_Ok() {
+	a, err := NewFromString("10.5")
+	assert.NoError(suite.T(), err)
+	b := NewFromInt(4)
+
+	assert.Equal(suite.T(), "14.5", a.Add(b).StringFixed(1))
+	assert.Equal(suite.T(), "6.5", a.Sub(b).StringFixed(1))
+	assert.Equal(suite.T(), "42.0", a.Mul(b).StringFixed(1))
+	assert.Equal(suite.T(), "2.625", a.Div(b).StringFixed(3))
+	assert.Equal(suite.T(), 1, a.Cmp(b))
+}
+
+func (suite *DecimalTestSuite) Test_Fail() {
+	_, err := NewFromString("bla-bla")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), errorInvalidValue, err.Error())
+
+	assert.NoError(suite.T(), ValidateRoundingMode(RoundHalfUp))
+	assert.Error(suite.T(), ValidateRoundingMode("bla-bla"))
+}
+
+func (suite *DecimalTestSuite) Test_Div_ByZero() {
+	a := NewFromInt(1)
+	assert.Panics(suite.T(), func() { a.Div(Zero()) })
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"math"
+	"time"
+)
+
+const (
+	collectionNameQuarantinedRates = "quarantined_rates"
+
+	anomalyActionQuarantine = "quarantine"
+	anomalyActionReject     = "reject"
+
+	anomalyReferencePrevious = "previous"
+
+	quarantineStatusPending  = "pending"
+	quarantineStatusApproved = "approved"
+	quarantineStatusRejected = "rejected"
+
+	// rates older than this period are not used as a reference
+	anomalyReferencePeriodDays = 31
+
+	errorRatesQuarantined      = "rates batch quarantined due to anomalous rates"
+	errorRatesAnomalyRejected  = "anomalous rates rejected"
+	errorRatesReferenceFailed  = "reference rates request failed"
+	errorRatesQuarantineFailed = "rates batch quarantine failed"
+	errorAnomalyActionInvalid  = "anomaly action invalid"
+)
+
+// rateAnomaly - rate that moved from its reference rate more than the threshold
+type rateAnomaly struct {
+	Pair          string  `bson:"pair"`
+	Rate          float64 `bson:"rate"`
+	Reference     string  `bson:"reference"`
+	ReferenceRate float64 `bson:"reference_rate"`
+	Deviation     float64 `bson:"deviation"`
+	Threshold     float64 `bson:"threshold"`
+}
+
+// quarantinedBatch - batch of rates held back from saving because of anomalies
+type quarantinedBatch struct {
+	Id         bson.ObjectId          `bson:"_id"`
+	Source     string                 `bson:"source"`
+	RateType   string                 `bson:"rate_type"`
+	Rates      []*currencies.RateData `bson:"rates"`
+	Anomalies  []*rateAnomaly         `bson:"anomalies"`
+	Status     string                 `bson:"status"`
+	CreatedAt  time.Time              `bson:"created_at"`
+	ReviewedBy string                 `bson:"reviewed_by,omitempty"`
+	ReviewedAt *time.Time             `bson:"reviewed_at,omitempty"`
+	Comment    string                 `bson:"comment,omitempty"`
+}
+
+// errRatesQuarantined - the rates are saved on approve of the batch, so the caller doesn't retry them
+var errRatesQuarantined = errors.New(errorRatesQuarantined)
+
+var anomalyActions = map[string]bool{
+	anomalyActionQuarantine: true,
+	anomalyActionReject:     true,
+}
+
+// checkRatesAnomalies - compares each new rate with the previous stored rate of the source
+// and with the OXR rate of the same pair, the rates effective on passed date are the reference.
+// Depending on the anomaly action, the whole batch is quarantined, or the anomalous rates are removed from it.
+// Derived rate types are calculated from already checked rates and are not checked
+func (s *Service) checkRatesAnomalies(src RateSource, rates []interface{}, date time.Time) ([]interface{}, error) {
+	if s.getConfig().AnomalyThreshold <= 0 || len(rates) == 0 || isDerivedRateType(src.RateType()) {
+		return rates, nil
+	}
+
+	anomalies, err := s.getRatesAnomalies(src, rates, date)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(anomalies) == 0 {
+		return rates, nil
+	}
+
+	for _, a := range anomalies {
+		zap.S().Warnw("Anomalous rate", "source", src.Name(), "pair", a.Pair, "rate", a.Rate,
+			"reference", a.Reference, "referenceRate", a.ReferenceRate, "deviation", a.Deviation)
+	}
+
+	if s.getConfig().AnomalyAction == anomalyActionReject {
+		rejected := make(map[string]bool, len(anomalies))
+		for _, a := range anomalies {
+			rejected[a.Pair] = true
+		}
+
+		var res []interface{}
+		for _, item := range rates {
+			if rd, ok := item.(*currencies.RateData); ok && rejected[rd.Pair] {
+				continue
+			}
+			res = append(res, item)
+		}
+
+		s.sendCentrifugoMessage(errorRatesAnomalyRejected, s.getAnomaliesError(src, anomalies))
+		return res, nil
+	}
+
+	batch := &quarantinedBatch{
+		Id:        bson.NewObjectId(),
+		Source:    src.Name(),
+		RateType:  src.RateType(),
+		Anomalies: anomalies,
+		Status:    quarantineStatusPending,
+		CreatedAt: time.Now(),
+	}
+	for _, item := range rates {
+		if rd, ok := item.(*currencies.RateData); ok {
+			batch.Rates = append(batch.Rates, rd)
+		}
+	}
+
+	err = s.db.Collection(collectionNameQuarantinedRates).Insert(batch)
+	if err != nil {
+		zap.S().Errorw(errorRatesQuarantineFailed, "error", err, "source", src.Name())
+		s.sendCentrifugoMessage(errorRatesQuarantineFailed, err)
+		return nil, err
+	}
+
+	err = s.getAnomaliesError(src, anomalies)
+	zap.S().Errorw(errorRatesQuarantined, "error", err, "source", src.Name(), "batch", batch.Id.Hex())
+	s.sendCentrifugoMessage(errorRatesQuarantined, fmt.Errorf("%s, batch: %s", err.Error(), batch.Id.Hex()))
+
+	return nil, errRatesQuarantined
+}
+
+func (s *Service) getRatesAnomalies(src RateSource, rates []interface{}, date time.Time) ([]*rateAnomaly, error) {
+	var pairs []string
+	for _, item := range rates {
+		if rd, ok := item.(*currencies.RateData); ok {
+			pairs = append(pairs, rd.Pair)
+		}
+	}
+
+	previous, err := s.getReferenceRates(src.RateType(), src.Name(), pairs, date)
+	if err != nil {
+		return nil, err
+	}
+
+	// market rates are the reference for other rate types
+	var market map[string]float64
+	if src.RateType() != currencies.RateTypeOxr {
+		market, err = s.getReferenceRates(currencies.RateTypeOxr, oxrSource, pairs, date)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var anomalies []*rateAnomaly
+
+	for _, item := range rates {
+		rd, ok := item.(*currencies.RateData)
+		if !ok {
+			continue
+		}
+
+		threshold := s.getAnomalyThreshold(rd.Pair)
+
+		if a := s.getRateAnomaly(rd, anomalyReferencePrevious, previous[rd.Pair], threshold); a != nil {
+			anomalies = append(anomalies, a)
+			continue
+		}
+
+		if a := s.getRateAnomaly(rd, currencies.RateTypeOxr, market[rd.Pair], threshold); a != nil {
+			anomalies = append(anomalies, a)
+		}
+	}
+
+	return anomalies, nil
+}
+
+func (s *Service) getRateAnomaly(rd *currencies.RateData, reference string, referenceRate, threshold float64) *rateAnomaly {
+	if referenceRate <= 0 {
+		return nil
+	}
+
+	deviation := math.Abs(rd.Rate-referenceRate) / referenceRate * 100
+	if deviation <= threshold {
+		return nil
+	}
+
+	return &rateAnomaly{
+		Pair:          rd.Pair,
+		Rate:          rd.Rate,
+		Reference:     reference,
+		ReferenceRate: referenceRate,
+		Deviation:     deviation,
+		Threshold:     threshold,
+	}
+}
+
+// getReferenceRates - returns the latest rates of the source for passed pairs effective on passed date
+func (s *Service) getReferenceRates(rateType, source string, pairs []string, date time.Time) (map[string]float64, error) {
+	cName, err := s.getCollectionName(rateType)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"source":         source,
+				"pair":           bson.M{"$in": pairs},
+				"effective_date": bson.M{"$gte": date.AddDate(0, 0, -anomalyReferencePeriodDays), "$lte": date},
+			},
+		},
+		{"$sort": bson.D{{Name: "effective_date", Value: -1}, {Name: "_id", Value: -1}}},
+		{"$group": bson.M{"_id": "$pair", "rate": bson.M{"$first": "$rate"}}},
+	}
+
+	var items []struct {
+		Pair string  `bson:"_id"`
+		Rate float64 `bson:"rate"`
+	}
+
+	err = s.db.Collection(cName).Pipe(pipeline).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorRatesReferenceFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return nil, err
+	}
+
+	res := make(map[string]float64, len(items))
+	for _, item := range items {
+		res[item.Pair] = item.Rate
+	}
+
+	return res, nil
+}
+
+func (s *Service) getAnomalyThreshold(pair string) float64 {
+	if threshold, ok := s.getConfig().AnomalyPairThresholds[pair]; ok {
+		return threshold
+	}
+	return s.getConfig().AnomalyThreshold
+}
+
+func (s *Service) getAnomaliesError(src RateSource, anomalies []*rateAnomaly) error {
+	msg := fmt.Sprintf("source: %s, anomalies:", src.Name())
+	for _, a := range anomalies {
+		msg += fmt.Sprintf(" %s %.6f (%s %.6f, %.2f%%)", a.Pair, a.Rate, a.Reference, a.ReferenceRate, a.Deviation)
+	}
+	return errors.New(msg)
+}
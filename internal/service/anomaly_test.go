@@ -0,0 +1,103 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) saveAnomalyReferenceRates() {
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: oxrSource, Volume: 1},
+		&currencies.RateData{Pair: "USDEUR", Rate: 0.9, Source: oxrSource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: cbrfSource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestAnomaly_checkRatesAnomalies_Ok() {
+	suite.saveAnomalyReferenceRates()
+
+	src, ok := GetRateSource(cbrfSource)
+	assert.True(suite.T(), ok)
+
+	rates := []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r * 1.05, Source: cbrfSource, Volume: 1},
+		&currencies.RateData{Pair: "USDEUR", Rate: 0.95, Source: cbrfSource, Volume: 1},
+	}
+
+	res, err := suite.service.checkRatesAnomalies(src, rates, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res, 2)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestAnomaly_checkRatesAnomalies_Quarantine() {
+	suite.saveAnomalyReferenceRates()
+
+	src, ok := GetRateSource(cbrfSource)
+	assert.True(suite.T(), ok)
+
+	rates := []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r * 1.2, Source: cbrfSource, Volume: 1},
+		&currencies.RateData{Pair: "USDEUR", Rate: 0.9, Source: cbrfSource, Volume: 1},
+	}
+
+	res, err := suite.service.checkRatesAnomalies(src, rates, time.Now())
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err, errRatesQuarantined)
+	assert.Nil(suite.T(), res)
+
+	batch := &quarantinedBatch{}
+	err = suite.service.db.Collection(collectionNameQuarantinedRates).Find(bson.M{"source": cbrfSource}).One(batch)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), batch.Status, quarantineStatusPending)
+	assert.Len(suite.T(), batch.Rates, 2)
+	assert.Len(suite.T(), batch.Anomalies, 1)
+	assert.Equal(suite.T(), batch.Anomalies[0].Pair, "USDRUB")
+	assert.Equal(suite.T(), batch.Anomalies[0].Reference, anomalyReferencePrevious)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestAnomaly_checkRatesAnomalies_Reject() {
+	suite.saveAnomalyReferenceRates()
+	suite.service.getConfig().AnomalyAction = anomalyActionReject
+
+	src, ok := GetRateSource(cbrfSource)
+	assert.True(suite.T(), ok)
+
+	// there is no previous CBRF rate for USDEUR, so it is compared with OXR rate
+	rates := []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: cbrfSource, Volume: 1},
+		&currencies.RateData{Pair: "USDEUR", Rate: 1.2, Source: cbrfSource, Volume: 1},
+	}
+
+	res, err := suite.service.checkRatesAnomalies(src, rates, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+	assert.Equal(suite.T(), res[0].(*currencies.RateData).Pair, "USDRUB")
+
+	n, err := suite.service.db.Collection(collectionNameQuarantinedRates).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), n, 0)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestAnomaly_getAnomalyThreshold() {
+	suite.service.getConfig().AnomalyPairThresholds = map[string]float64{"USDTRY": 25}
+
+	assert.Equal(suite.T(), suite.service.getAnomalyThreshold("USDTRY"), float64(25))
+	assert.Equal(suite.T(), suite.service.getAnomalyThreshold("USDRUB"), suite.service.getConfig().AnomalyThreshold)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestAnomaly_checkRatesAnomalies_DerivedSkipped() {
+	src, ok := GetRateSource(stockSource)
+	assert.True(suite.T(), ok)
+
+	rates := []interface{}{&currencies.RateData{Pair: "USDRUB", Rate: r * 10, Source: stockSource}}
+	res, err := suite.service.checkRatesAnomalies(src, rates, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+}
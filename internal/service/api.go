@@ -2,10 +2,9 @@ package service
 
 import (
 	"context"
-	"errors"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"time"
 )
@@ -33,12 +32,13 @@ func (s *Service) GetRateCurrentCommon(
 	if req.RateType == currencies.RateTypeCardpay {
 		query = s.getByDateQuery(time.Now())
 	}
+	query = s.getSideQuery(req.RateType, req.ExchangeDirection, query)
 	err := s.getRate(req.RateType, req.From, req.To, query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorGetRateCurrentCommonRequest, "error", err, "req", req)
 		return err
 	}
-	s.applyCorrection(res, req.RateType, req.ExchangeDirection, "")
+	s.applyCorrection(res, req.RateType, req.ExchangeDirection, "", time.Now())
 	return nil
 }
 
@@ -54,13 +54,14 @@ func (s *Service) GetRateByDateCommon(
 		return err
 	}
 
-	err = s.getRateByDate(req.RateType, req.From, req.To, dt, req.Source, res)
+	query := s.getSideQuery(req.RateType, req.ExchangeDirection, s.getByDateQuery(dt))
+	err = s.getRate(req.RateType, req.From, req.To, query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorGetRateByDateCommonRequest, "error", err, "req", req)
 		return err
 	}
 
-	s.applyCorrection(res, req.RateType, req.ExchangeDirection, "")
+	s.applyCorrection(res, req.RateType, req.ExchangeDirection, "", dt)
 	return nil
 }
 
@@ -72,7 +73,7 @@ func (s *Service) GetRateCurrentForMerchant(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 
 	query := bson.M{}
@@ -80,12 +81,13 @@ func (s *Service) GetRateCurrentForMerchant(
 		query = s.getByDateQuery(time.Now())
 	}
 
+	query = s.getSideQuery(req.RateType, req.ExchangeDirection, query)
 	err := s.getRate(req.RateType, req.From, req.To, query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorGetRateCurrentForMerchantRequest, "error", err, "req", req)
 		return err
 	}
-	s.applyCorrection(res, req.RateType, req.ExchangeDirection, req.MerchantId)
+	s.applyCorrection(res, req.RateType, req.ExchangeDirection, req.MerchantId, time.Now())
 	return nil
 }
 
@@ -97,7 +99,7 @@ func (s *Service) GetRateByDateForMerchant(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 
 	dt, err := ptypes.Timestamp(req.Datetime)
@@ -106,13 +108,14 @@ func (s *Service) GetRateByDateForMerchant(
 		return err
 	}
 
-	err = s.getRateByDate(req.RateType, req.From, req.To, dt, req.Source, res)
+	query := s.getSideQuery(req.RateType, req.ExchangeDirection, s.getByDateQuery(dt))
+	err = s.getRate(req.RateType, req.From, req.To, query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorGetRateByDateForMerchantRequest, "error", err, "req", req)
 		return err
 	}
 
-	s.applyCorrection(res, req.RateType, req.ExchangeDirection, req.MerchantId)
+	s.applyCorrection(res, req.RateType, req.ExchangeDirection, req.MerchantId, dt)
 	return nil
 }
 
@@ -126,7 +129,7 @@ func (s *Service) ExchangeCurrencyCurrentCommon(
 	if req.RateType == currencies.RateTypeCardpay {
 		query = s.getByDateQuery(time.Now())
 	}
-	err := s.exchangeCurrency(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, "", query, req.Source, res)
+	err := s.exchangeCurrency(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.RoundingMode, "", time.Now(), query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorExchangeCurrencyCurrentCommon, "error", err, "req", req)
 		return err
@@ -142,13 +145,13 @@ func (s *Service) ExchangeCurrencyCurrentForMerchant(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 	query := bson.M{}
 	if req.RateType == currencies.RateTypeCardpay {
 		query = s.getByDateQuery(time.Now())
 	}
-	err := s.exchangeCurrency(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.MerchantId, query, req.Source, res)
+	err := s.exchangeCurrency(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.RoundingMode, req.MerchantId, time.Now(), query, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorExchangeCurrencyCurrentForMerchant, "error", err, "req", req)
 		return err
@@ -168,7 +171,7 @@ func (s *Service) ExchangeCurrencyByDateCommon(
 		return err
 	}
 
-	err = s.exchangeCurrencyByDate(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, "", dt, req.Source, res)
+	err = s.exchangeCurrencyByDate(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.RoundingMode, "", dt, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorExchangeCurrencyByDateCommon, "error", err, "req", req)
 		return err
@@ -184,7 +187,7 @@ func (s *Service) ExchangeCurrencyByDateForMerchant(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 
 	dt, err := ptypes.Timestamp(req.Datetime)
@@ -193,7 +196,7 @@ func (s *Service) ExchangeCurrencyByDateForMerchant(
 		return err
 	}
 
-	err = s.exchangeCurrencyByDate(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.MerchantId, dt, req.Source, res)
+	err = s.exchangeCurrencyByDate(req.RateType, req.ExchangeDirection, req.From, req.To, req.Amount, req.RoundingMode, req.MerchantId, dt, req.Source, res)
 	if err != nil {
 		zap.S().Errorw(errorExchangeCurrencyByDateForMerchant, "error", err, "req", req)
 		return err
@@ -207,7 +210,13 @@ func (s *Service) GetCommonRateCorrectionRule(
 	req *currencies.CommonCorrectionRuleRequest,
 	res *currencies.CorrectionRule,
 ) error {
-	cr, err := s.getCorrectionRule(req.RateType, req.ExchangeDirection, "")
+	date, err := s.getCorrectionRuleDate(req.Datetime)
+	if err != nil {
+		zap.S().Errorw(errorDatetimeConversion, "error", err, "req", req)
+		return err
+	}
+
+	cr, err := s.getCorrectionRule(req.RateType, req.ExchangeDirection, "", date)
 	if err != nil {
 		zap.S().Errorw(errorCorrectionRuleNotFound, "error", err, "req", req)
 		return err
@@ -219,6 +228,12 @@ func (s *Service) GetCommonRateCorrectionRule(
 	res.CommonCorrection = cr.CommonCorrection
 	res.PairCorrection = cr.PairCorrection
 	res.CreatedAt = cr.CreatedAt
+	res.ValidFrom = cr.ValidFrom
+	res.ValidTo = cr.ValidTo
+	res.Kind = s.getCorrectionRuleKind(cr)
+	res.CommonSpread = cr.CommonSpread
+	res.PairSpread = cr.PairSpread
+	res.Tiers = cr.Tiers
 
 	return nil
 }
@@ -231,10 +246,16 @@ func (s *Service) GetMerchantRateCorrectionRule(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 
-	cr, err := s.getCorrectionRule(req.RateType, req.ExchangeDirection, req.MerchantId)
+	date, err := s.getCorrectionRuleDate(req.Datetime)
+	if err != nil {
+		zap.S().Errorw(errorDatetimeConversion, "error", err, "req", req)
+		return err
+	}
+
+	cr, err := s.getCorrectionRule(req.RateType, req.ExchangeDirection, req.MerchantId, date)
 	if err != nil {
 		zap.S().Errorw(errorCorrectionRuleNotFound, "error", err, "req", req)
 		return err
@@ -246,6 +267,12 @@ func (s *Service) GetMerchantRateCorrectionRule(
 	res.CommonCorrection = cr.CommonCorrection
 	res.PairCorrection = cr.PairCorrection
 	res.CreatedAt = cr.CreatedAt
+	res.ValidFrom = cr.ValidFrom
+	res.ValidTo = cr.ValidTo
+	res.Kind = s.getCorrectionRuleKind(cr)
+	res.CommonSpread = cr.CommonSpread
+	res.PairSpread = cr.PairSpread
+	res.Tiers = cr.Tiers
 
 	return nil
 }
@@ -256,7 +283,18 @@ func (s *Service) AddCommonRateCorrectionRule(
 	req *currencies.CommonCorrectionRule,
 	res *currencies.EmptyResponse,
 ) error {
-	return s.addCorrectionRule(req.RateType, req.ExchangeDirection, req.CommonCorrection, req.PairCorrection, "")
+	return s.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          req.RateType,
+		ExchangeDirection: req.ExchangeDirection,
+		CommonCorrection:  req.CommonCorrection,
+		PairCorrection:    req.PairCorrection,
+		ValidFrom:         req.ValidFrom,
+		ValidTo:           req.ValidTo,
+		Kind:              req.Kind,
+		CommonSpread:      req.CommonSpread,
+		PairSpread:        req.PairSpread,
+		Tiers:             req.Tiers,
+	})
 }
 
 // AddMerchantRateCorrectionRule - adding new merchant's correction rule for passed rate type and merchant id
@@ -267,10 +305,10 @@ func (s *Service) AddMerchantRateCorrectionRule(
 ) error {
 	if req.MerchantId == "" {
 		zap.S().Errorw(errorMerchantIdRequired, "req", req)
-		return errors.New(errorMerchantIdRequired)
+		return errMerchantIdRequired
 	}
 
-	return s.addCorrectionRule(req.RateType, req.ExchangeDirection, req.CommonCorrection, req.PairCorrection, req.MerchantId)
+	return s.addCorrectionRule(req)
 }
 
 // GetSupportedCurrencies - returns list of all supported currencies
@@ -279,7 +317,7 @@ func (s *Service) GetSupportedCurrencies(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesList,
 ) error {
-	for _, v := range s.cfg.SupportedCurrencies {
+	for _, v := range s.getConfig().SupportedCurrencies {
 		res.Currencies = append(res.Currencies, v)
 	}
 	return nil
@@ -291,7 +329,7 @@ func (s *Service) GetSettlementCurrencies(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesList,
 ) error {
-	for _, v := range s.cfg.SettlementCurrencies {
+	for _, v := range s.getConfig().SettlementCurrencies {
 		res.Currencies = append(res.Currencies, v)
 	}
 	return nil
@@ -303,7 +341,7 @@ func (s *Service) GetPriceCurrencies(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesList,
 ) error {
-	for _, v := range s.cfg.PriceCurrencies {
+	for _, v := range s.getConfig().PriceCurrencies {
 		res.Currencies = append(res.Currencies, v)
 	}
 	return nil
@@ -315,7 +353,7 @@ func (s *Service) GetVatCurrencies(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesList,
 ) error {
-	for _, v := range s.cfg.VatCurrencies {
+	for _, v := range s.getConfig().VatCurrencies {
 		res.Currencies = append(res.Currencies, v)
 	}
 	return nil
@@ -327,7 +365,7 @@ func (s *Service) GetAccountingCurrencies(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesList,
 ) error {
-	for _, v := range s.cfg.AccountingCurrencies {
+	for _, v := range s.getConfig().AccountingCurrencies {
 		res.Currencies = append(res.Currencies, v)
 	}
 	return nil
@@ -339,6 +377,16 @@ func (s *Service) GetCurrenciesPrecision(
 	req *currencies.EmptyRequest,
 	res *currencies.CurrenciesPrecisionResponse,
 ) error {
-	res.Values = s.cfg.CurrenciesPrecision
+	res.Values = s.getConfig().CurrenciesPrecision
+	return nil
+}
+
+// GetCurrenciesMinorUnits - returns map of currencies with theirs ISO 4217 minor units
+func (s *Service) GetCurrenciesMinorUnits(
+	ctx context.Context,
+	req *currencies.EmptyRequest,
+	res *currencies.CurrenciesPrecisionResponse,
+) error {
+	res.Values = s.getConfig().CurrenciesMinorUnits
 	return nil
 }
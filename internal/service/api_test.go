@@ -5,7 +5,7 @@ import (
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
-	"github.com/paysuper/paysuper-proto/go/currenciespb"
+	currenciespb "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -71,6 +71,10 @@ func (suite *CurrenciesratesServiceTestSuite) Test_GetRateCurrentCommon_CbFallba
 	assert.Equal(suite.T(), res.Pair, "USDRUB")
 	assert.Equal(suite.T(), res.Rate, r)
 	assert.Equal(suite.T(), res.Source, "TEST")
+	assert.NotNil(suite.T(), res.Provenance)
+	assert.True(suite.T(), res.Provenance.Fallback)
+	assert.Equal(suite.T(), res.Provenance.RateType, currenciespb.RateTypeOxr)
+	assert.Equal(suite.T(), res.Provenance.RateId, res.Id)
 
 	rd := &currenciespb.RateData{
 		Pair:   "USDRUB",
@@ -85,6 +89,8 @@ func (suite *CurrenciesratesServiceTestSuite) Test_GetRateCurrentCommon_CbFallba
 	assert.Equal(suite.T(), res.Pair, "USDRUB")
 	assert.Equal(suite.T(), res.Rate, r+1)
 	assert.Equal(suite.T(), res.Source, cbrfSource)
+	assert.False(suite.T(), res.Provenance.Fallback)
+	assert.Equal(suite.T(), res.Provenance.RateType, currenciespb.RateTypeCentralbanks)
 }
 
 func (suite *CurrenciesratesServiceTestSuite) Test_GetRateCurrentCommon_Fail() {
@@ -531,3 +537,14 @@ func (suite *CurrenciesratesServiceTestSuite) Test_GetCurrenciesPrecision_Ok() {
 	assert.EqualValues(suite.T(), res.Values["CLP"], 0)
 	assert.EqualValues(suite.T(), res.Values["BHD"], 2)
 }
+
+func (suite *CurrenciesratesServiceTestSuite) Test_GetCurrenciesMinorUnits_Ok() {
+	req := &currenciespb.EmptyRequest{}
+	res := &currenciespb.CurrenciesPrecisionResponse{}
+	err := suite.service.GetCurrenciesMinorUnits(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), res.Values["RUB"], 2)
+	assert.EqualValues(suite.T(), res.Values["CLP"], 0)
+	assert.EqualValues(suite.T(), res.Values["BHD"], 3)
+	assert.EqualValues(suite.T(), res.Values["KWD"], 3)
+}
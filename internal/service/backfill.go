@@ -0,0 +1,214 @@
+package service
+
+import (
+	"errors"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/now"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"sort"
+	"time"
+)
+
+const (
+	// number of days requested from the source at once, the rates are saved after each chunk
+	backfillChunkDays = 31
+
+	errorBackfillNotSupported = "rates source does not support historical rates"
+	errorBackfillDatesInvalid = "backfill dates range invalid"
+	errorBackfillCheckFailed  = "backfill existing rates check failed"
+)
+
+// BackfillRates - retrieving historical rates for passed rate type or source name and dates range,
+// effective dates that already have rates of the source are skipped
+func (s *Service) BackfillRates(name string, from, to time.Time) error {
+	from = now.New(from).BeginningOfDay()
+	to = now.New(to).BeginningOfDay()
+
+	if from.After(to) || to.After(time.Now()) {
+		return errors.New(errorBackfillDatesInvalid)
+	}
+
+	sources := GetRateSourcesByType(name)
+	if len(sources) == 0 {
+		src, ok := GetRateSource(name)
+		if !ok {
+			zap.S().Errorw(errorRateSourceNotFound, "source", name)
+			return errors.New(errorRateSourceNotFound)
+		}
+		sources = []RateSource{src}
+	}
+
+	for _, src := range sources {
+		hs, ok := src.(HistoricalRateSource)
+		if !ok {
+			zap.S().Warnw(errorBackfillNotSupported, "source", src.Name())
+			continue
+		}
+
+		if err := s.backfillSource(hs, from, to); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// backfillSource - requests and saves the rates by chunks of missing dates, so the saved chunks are kept if the run fails,
+// and the restarted run doesn't request the dates that have rates already
+func (s *Service) backfillSource(src HistoricalRateSource, from, to time.Time) error {
+	zap.S().Infow("Requesting historical rates", "source", src.Name(), "from", from, "to", to)
+
+	inserted := 0
+
+	for start := from; !start.After(to); {
+		exists, err := s.hasRatesForDate(src, start)
+		if err != nil {
+			return err
+		}
+		if exists {
+			zap.S().Infow("Historical rates skipped, the date has rates", "source", src.Name(), "date", start)
+			start = start.AddDate(0, 0, 1)
+			continue
+		}
+
+		end, err := s.getBackfillChunkEnd(src, start, to)
+		if err != nil {
+			return err
+		}
+
+		rates, err := src.FetchHistory(s, start, end)
+		if err != nil {
+			return err
+		}
+
+		n, err := s.saveHistoricalRates(src, rates)
+		if err != nil {
+			return err
+		}
+		inserted += n
+
+		zap.S().Infow("Historical rates saved", "source", src.Name(), "from", start, "to", end, "inserted", n)
+		start = end.AddDate(0, 0, 1)
+	}
+
+	zap.S().Infow("Historical rates updated", "source", src.Name(), "inserted", inserted)
+
+	return nil
+}
+
+// getBackfillChunkEnd - returns the last date of the chunk of missing dates from the start date,
+// the chunk ends before the first date that has rates and is limited by backfillChunkDays
+func (s *Service) getBackfillChunkEnd(src RateSource, start, to time.Time) (time.Time, error) {
+	end := start
+	limit := start.AddDate(0, 0, backfillChunkDays)
+
+	for date := start.AddDate(0, 0, 1); !date.After(to) && date.Before(limit); date = date.AddDate(0, 0, 1) {
+		exists, err := s.hasRatesForDate(src, date)
+		if err != nil {
+			return end, err
+		}
+		if exists {
+			break
+		}
+		end = date
+	}
+
+	return end, nil
+}
+
+// saveHistoricalRates - saves the fetched rates grouped by effective date, the source may return the rates
+// of another date than requested, like the latest table before a holiday, so each date is checked for existing rates.
+// Returns the number of saved dates
+func (s *Service) saveHistoricalRates(src HistoricalRateSource, rates []interface{}) (int, error) {
+	var (
+		dates    []time.Time
+		byDate   = make(map[time.Time][]interface{})
+		inserted = 0
+	)
+
+	for _, item := range rates {
+		rd, ok := item.(*currencies.RateData)
+		if !ok {
+			continue
+		}
+
+		date, err := ptypes.Timestamp(rd.EffectiveDate)
+		if err != nil {
+			zap.S().Errorw(errorDatetimeConversion, "error", err, "rate", rd)
+			return inserted, err
+		}
+
+		date = now.New(date).BeginningOfDay()
+		if _, ok := byDate[date]; !ok {
+			dates = append(dates, date)
+		}
+		byDate[date] = append(byDate[date], rd)
+	}
+
+	// dates are saved in order, so the rates of previous date are the reference for anomalies check of the next one
+	sort.Slice(dates, func(i, j int) bool {
+		return dates[i].Before(dates[j])
+	})
+
+	for _, date := range dates {
+		exists, err := s.hasRatesForDate(src, date)
+		if err != nil {
+			return inserted, err
+		}
+		if exists {
+			zap.S().Infow("Historical rates skipped, the date has rates", "source", src.Name(), "date", date)
+			continue
+		}
+
+		// the quarantined date is saved on approve, backfill continues with the next dates
+		dayRates, err := s.checkRatesAnomalies(src, byDate[date], date)
+		if err != nil {
+			if err == errRatesQuarantined {
+				zap.S().Infow("Historical rates skipped, the rates are quarantined", "source", src.Name(), "date", date)
+				continue
+			}
+			return inserted, err
+		}
+		if len(dayRates) == 0 {
+			continue
+		}
+
+		err = s.saveRates(src.RateType(), dayRates)
+		if err != nil {
+			zap.S().Errorw(errorRatesSaveFailed, "error", err, "source", src.Name(), "date", date)
+			s.sendCentrifugoMessage(errorRatesSaveFailed, err)
+			return inserted, err
+		}
+		inserted++
+	}
+
+	return inserted, nil
+}
+
+func (s *Service) hasRatesForDate(src RateSource, date time.Time) (bool, error) {
+	cName, err := s.getCollectionName(src.RateType())
+	if err != nil {
+		return false, err
+	}
+
+	query := bson.M{
+		"source":         src.Name(),
+		"effective_date": bson.M{"$gte": date, "$lte": now.New(date).EndOfDay()},
+	}
+
+	n, err := s.db.Collection(cName).Find(query).Limit(1).Count()
+	if err != nil {
+		zap.L().Error(
+			errorBackfillCheckFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return false, err
+	}
+
+	return n > 0, nil
+}
@@ -0,0 +1,141 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+const backfillTestSource = "BACKFILLTEST"
+
+var backfillTestRequests = 0
+
+func newBackfillTestSource() RateSource {
+	return NewHistoricalRateSource(
+		backfillTestSource,
+		currencies.RateTypeStock,
+		"",
+		func(s *Service) ([]interface{}, error) {
+			return nil, nil
+		},
+		func(s *Service, from, to time.Time) ([]interface{}, error) {
+			backfillTestRequests++
+
+			var rates []interface{}
+			for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+				dayRates := []interface{}{
+					&currencies.RateData{
+						Pair:   "USDRUB",
+						Rate:   r,
+						Source: backfillTestSource,
+						Volume: 1,
+					},
+				}
+				if err := s.setRatesEffectiveDate(dayRates, date); err != nil {
+					return nil, err
+				}
+				rates = append(rates, dayRates...)
+			}
+			return rates, nil
+		},
+	)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) countBackfillTestRates() int {
+	cName, err := suite.service.getCollectionName(collectionRatesNameSuffixStock)
+	assert.NoError(suite.T(), err)
+
+	n, err := suite.service.db.Collection(cName).Find(bson.M{"source": backfillTestSource}).Count()
+	assert.NoError(suite.T(), err)
+	return n
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBackfill_BackfillRates_Ok() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixStock)
+	assert.NoError(suite.T(), err)
+
+	to := time.Now().AddDate(0, 0, -1)
+	from := to.AddDate(0, 0, -4)
+
+	err = suite.service.BackfillRates(backfillTestSource, from, to)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.countBackfillTestRates(), 5)
+
+	res := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeStock, "USD", "RUB", suite.service.getByDateQuery(from), backfillTestSource, res)
+	assert.NoError(suite.T(), err)
+	effectiveDate, err := ptypes.Timestamp(res.EffectiveDate)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), effectiveDate.Format(dateFormatLayout), from.Format(dateFormatLayout))
+
+	// existing dates are skipped on the second run without requesting them
+	requests := backfillTestRequests
+	err = suite.service.BackfillRates(backfillTestSource, from.AddDate(0, 0, -1), to)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.countBackfillTestRates(), 6)
+	assert.Equal(suite.T(), backfillTestRequests, requests+1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBackfill_BackfillRates_Gap() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixStock)
+	assert.NoError(suite.T(), err)
+
+	to := time.Now().AddDate(0, 0, -1)
+	from := to.AddDate(0, 0, -4)
+	middle := from.AddDate(0, 0, 2)
+
+	err = suite.service.BackfillRates(backfillTestSource, middle, middle)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.countBackfillTestRates(), 1)
+
+	// the missing dates before and after the saved one are requested by separate chunks
+	requests := backfillTestRequests
+	err = suite.service.BackfillRates(backfillTestSource, from, to)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.countBackfillTestRates(), 5)
+	assert.Equal(suite.T(), backfillTestRequests, requests+2)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBackfill_BackfillRates_ByType_SkipsNotHistorical() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixStock)
+	assert.NoError(suite.T(), err)
+
+	requests := backfillTestRequests
+	date := time.Now().AddDate(0, 0, -1)
+
+	// stock type contains other sources that are not historical, they must be skipped
+	err = suite.service.BackfillRates(currencies.RateTypeStock, date, date)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), backfillTestRequests, requests+1)
+	assert.Equal(suite.T(), suite.countBackfillTestRates(), 1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBackfill_BackfillRates_Fail() {
+	err := suite.service.BackfillRates("bla-bla", time.Now().AddDate(0, 0, -1), time.Now())
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorRateSourceNotFound)
+
+	err = suite.service.BackfillRates(backfillTestSource, time.Now(), time.Now().AddDate(0, 0, -1))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorBackfillDatesInvalid)
+
+	err = suite.service.BackfillRates(backfillTestSource, time.Now(), time.Now().AddDate(0, 0, 1))
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorBackfillDatesInvalid)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBackfill_HistoricalSources() {
+	for _, name := range []string{cbrfSource, cbeuSource, cbcaSource, cbplSource, oxrSource} {
+		src, ok := GetRateSource(name)
+		assert.True(suite.T(), ok)
+		_, ok = src.(HistoricalRateSource)
+		assert.True(suite.T(), ok, name)
+	}
+
+	src, ok := GetRateSource(cbtrSource)
+	assert.True(suite.T(), ok)
+	_, ok = src.(HistoricalRateSource)
+	assert.False(suite.T(), ok)
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/now"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	errorBatchItemsLimit    = "batch items limit exceeded"
+	errorBatchAmountInvalid = "amount invalid"
+	errorBatchRatesFailed   = "batch rates request failed"
+)
+
+// batchRate - the rate of the batch item and the correction rule to apply to it
+type batchRate struct {
+	rate *currencies.RateData
+	rule *currencies.CorrectionRule
+	err  error
+}
+
+// GetRatesBatch - returns the rates of many pairs with correction rules applied, the errors are returned per item
+func (s *Service) GetRatesBatch(
+	ctx context.Context,
+	req *currencies.BatchRequest,
+	res *currencies.RatesBatchResponse,
+) error {
+	items, err := s.getBatchRates(req)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if item.err != nil {
+			res.Items = append(res.Items, &currencies.RatesBatchResult{Error: item.err.Error()})
+			continue
+		}
+
+		s.setCorrectionProvenance(item.rate, item.rule)
+		// the rates are requested without amount, as in GetRate* methods
+		s.applyCorrectionRule(item.rate, item.rule, 0)
+		res.Items = append(res.Items, &currencies.RatesBatchResult{Rate: item.rate})
+	}
+
+	zap.S().Infow("batch rates", "rateType", req.RateType, "merchantId", req.MerchantId, "items", len(req.Items))
+
+	return nil
+}
+
+// ExchangeCurrencyBatch - exchanges many amounts with correction rules applied, the errors are returned per item
+func (s *Service) ExchangeCurrencyBatch(
+	ctx context.Context,
+	req *currencies.BatchRequest,
+	res *currencies.ExchangeCurrencyBatchResponse,
+) error {
+	items, err := s.getBatchRates(req)
+	if err != nil {
+		return err
+	}
+
+	for i, item := range items {
+		if item.err == nil && req.Items[i].Amount < 0 {
+			item.err = errors.New(errorBatchAmountInvalid)
+		}
+
+		if item.err != nil {
+			res.Items = append(res.Items, &currencies.ExchangeCurrencyBatchResult{Error: item.err.Error()})
+			continue
+		}
+
+		result := &currencies.ExchangeCurrencyResponse{}
+		s.exchangeAmount(item.rate, item.rule, req.ExchangeDirection, req.Items[i].To, req.Items[i].Amount, req.RoundingMode, result)
+		res.Items = append(res.Items, &currencies.ExchangeCurrencyBatchResult{Result: result})
+	}
+
+	zap.S().Infow("batch exchange currency", "rateType", req.RateType, "merchantId", req.MerchantId, "items", len(req.Items))
+
+	return nil
+}
+
+// getBatchRates - resolves the rates and the correction rules of the batch items in the order of the items.
+// The direct rates of the items of the same day are requested by one query, the rules are requested once per time,
+// other rates (cross rates, central bank fallback) are requested one by one
+func (s *Service) getBatchRates(req *currencies.BatchRequest) ([]*batchRate, error) {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return nil, err
+	}
+
+	if len(req.Items) > s.getConfig().BatchMaxItems {
+		zap.S().Errorw(errorBatchItemsLimit, "items", len(req.Items), "limit", s.getConfig().BatchMaxItems)
+		return nil, errors.New(errorBatchItemsLimit)
+	}
+
+	t := time.Now()
+	res := make([]*batchRate, len(req.Items))
+	dates := make([]time.Time, len(req.Items))
+	queries := make(map[time.Time]bson.M)
+	pairs := make(map[time.Time][]string)
+
+	for i, item := range req.Items {
+		res[i] = &batchRate{}
+
+		// the current items are grouped by zero day
+		var day time.Time
+		dates[i] = t
+		if item.Datetime != nil {
+			date, err := ptypes.Timestamp(item.Datetime)
+			if err != nil {
+				res[i].err = err
+				continue
+			}
+			dates[i] = date
+			day = now.New(date).EndOfDay()
+		}
+
+		if _, ok := queries[day]; !ok {
+			queries[day] = s.getBatchQuery(req, day)
+		}
+		if item.From != item.To && s.isCurrencySupported(item.From) && s.isCurrencySupported(item.To) {
+			pairs[day] = append(pairs[day], item.From+item.To)
+		}
+	}
+
+	rates := make(map[time.Time]map[string]*currencies.RateData, len(queries))
+	for day, query := range queries {
+		latest, err := s.getLatestRates(req.RateType, req.Source, pairs[day], query)
+		if err != nil {
+			return nil, err
+		}
+		rates[day] = latest
+	}
+
+	rules := make(map[time.Time]*currencies.CorrectionRule)
+
+	for i, item := range req.Items {
+		if res[i].err != nil {
+			continue
+		}
+
+		var day time.Time
+		if item.Datetime != nil {
+			day = now.New(dates[i]).EndOfDay()
+		}
+
+		rd, ok := rates[day][item.From+item.To]
+		if !ok {
+			rd = &currencies.RateData{}
+			if err := s.getRate(req.RateType, item.From, item.To, s.copyQuery(queries[day]), req.Source, rd); err != nil {
+				res[i].err = err
+				continue
+			}
+			rates[day][item.From+item.To] = rd
+		}
+		// the rate is mutated by correction, so each item gets own copy
+		res[i].rate = proto.Clone(rd).(*currencies.RateData)
+
+		rule, ok := rules[dates[i]]
+		if !ok {
+			// the error is logged in getCorrectionRule, the rate is not corrected in this case
+			rule, _ = s.getCachedCorrectionRule(req.RateType, req.ExchangeDirection, req.MerchantId, dates[i])
+			if rule == nil {
+				rule = &currencies.CorrectionRule{}
+			}
+			rules[dates[i]] = rule
+		}
+		res[i].rule = rule
+	}
+
+	return res, nil
+}
+
+// getBatchQuery - returns the query of the rates for the batch items of the day, zero day for the current rates
+func (s *Service) getBatchQuery(req *currencies.BatchRequest, day time.Time) bson.M {
+	query := bson.M{}
+	if !day.IsZero() {
+		query = s.getByDateQuery(day)
+	} else if req.RateType == currencies.RateTypeCardpay {
+		query = s.getByDateQuery(time.Now())
+	}
+	return s.getSideQuery(req.RateType, req.ExchangeDirection, query)
+}
+
+// getLatestRates - returns the latest direct rates of the pairs matched the query by one request,
+// the pairs without direct rate are absent in the result
+func (s *Service) getLatestRates(rateType, source string, pairs []string, query bson.M) (map[string]*currencies.RateData, error) {
+	res := make(map[string]*currencies.RateData, len(pairs))
+	if len(pairs) == 0 {
+		return res, nil
+	}
+
+	cName, err := s.getCollectionName(rateType)
+	if err != nil {
+		return nil, err
+	}
+
+	match := s.copyQuery(query)
+	match["pair"] = bson.M{"$in": pairs}
+	s.setCurrentDateQuery(match)
+	if rateType == currencies.RateTypeCentralbanks {
+		match["source"] = strings.ToUpper(source)
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$sort": bson.D{{Name: "effective_date", Value: -1}, {Name: "_id", Value: -1}}},
+		{"$group": bson.M{"_id": "$pair", "rate": bson.M{"$first": "$$ROOT"}}},
+	}
+
+	var items []struct {
+		Rate *currencies.RateData `bson:"rate"`
+	}
+
+	err = s.db.Collection(cName).Pipe(pipeline).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorBatchRatesFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return nil, err
+	}
+
+	for _, item := range items {
+		s.setRateProvenance(item.Rate, rateType, false)
+		res[item.Rate.Pair] = item.Rate
+	}
+
+	return res, nil
+}
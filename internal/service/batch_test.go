@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestBatch_GetRatesBatch_Ok() {
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  1,
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.BatchRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		Items: []*currencies.BatchItem{
+			{From: "USD", To: "RUB"},
+			{From: "USD", To: "RUB", Datetime: ptypes.TimestampNow()},
+			{From: "USD", To: "USD"},
+			{From: "USD", To: "BLA"},
+			{From: "USD", To: "RUB"},
+		},
+	}
+	res := &currencies.RatesBatchResponse{}
+	err = suite.service.GetRatesBatch(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 5)
+
+	assert.Empty(suite.T(), res.Items[0].Error)
+	assert.Equal(suite.T(), res.Items[0].Rate.Pair, "USDRUB")
+	assert.Equal(suite.T(), res.Items[0].Rate.RateDecimal, "63.991486")
+	assert.NotEmpty(suite.T(), res.Items[0].Rate.Provenance.RateId)
+	assert.NotEmpty(suite.T(), res.Items[0].Rate.Provenance.CorrectionRuleId)
+
+	assert.Empty(suite.T(), res.Items[1].Error)
+	assert.Equal(suite.T(), res.Items[1].Rate.RateDecimal, "63.991486")
+
+	assert.Empty(suite.T(), res.Items[2].Error)
+	assert.Equal(suite.T(), res.Items[2].Rate.Source, stubSource)
+
+	assert.Nil(suite.T(), res.Items[3].Rate)
+	assert.Equal(suite.T(), res.Items[3].Error, errorToCurrencyNotSupported)
+
+	// the items of the same pair get own copies of the rate
+	assert.Equal(suite.T(), res.Items[4].Rate.RateDecimal, "63.991486")
+	assert.False(suite.T(), res.Items[0].Rate == res.Items[4].Rate)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBatch_ExchangeCurrencyBatch_Ok() {
+	past, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -10))
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.BatchRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		Items: []*currencies.BatchItem{
+			{From: "USD", To: "RUB", Amount: 100},
+			{From: "USD", To: "RUB", Amount: -1},
+			{From: "USD", To: "RUB", Amount: 100, Datetime: past},
+		},
+	}
+	res := &currencies.ExchangeCurrencyBatchResponse{}
+	err = suite.service.ExchangeCurrencyBatch(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 3)
+
+	assert.Empty(suite.T(), res.Items[0].Error)
+	assert.Equal(suite.T(), res.Items[0].Result.ExchangedAmount, float64(6463.14))
+	assert.Equal(suite.T(), res.Items[0].Result.OriginalRate, r)
+
+	assert.Nil(suite.T(), res.Items[1].Result)
+	assert.Equal(suite.T(), res.Items[1].Error, errorBatchAmountInvalid)
+
+	// there are no rates by the date
+	assert.Nil(suite.T(), res.Items[2].Result)
+	assert.NotEmpty(suite.T(), res.Items[2].Error)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestBatch_Fail() {
+	req := &currencies.BatchRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+	}
+	err := suite.service.GetRatesBatch(context.TODO(), req, &currencies.RatesBatchResponse{})
+	assert.Error(suite.T(), err)
+
+	suite.service.getConfig().BatchMaxItems = 1
+	req.Items = []*currencies.BatchItem{{From: "USD", To: "RUB"}, {From: "USD", To: "EUR"}}
+	err = suite.service.ExchangeCurrencyBatch(context.TODO(), req, &currencies.ExchangeCurrencyBatchResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorBatchItemsLimit)
+}
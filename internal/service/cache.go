@@ -0,0 +1,414 @@
+package service
+
+import (
+	"fmt"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/zap"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	cacheNameRates           = "rates"
+	cacheNameCorrectionRules = "correction_rules"
+
+	// versions of the caches, each process that changes the cached values increments the version of the cache
+	collectionNameCacheVersions = "cache_versions"
+
+	errorCacheVersionUpdate = "cache version update failed"
+	errorCacheVersionsCheck = "cache versions check failed"
+)
+
+var (
+	cacheHits = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "currencies",
+			Name:      "cache_hits_total",
+			Help:      "Number of the rates and correction rules found in the in-process cache",
+		},
+		[]string{"cache"},
+	)
+	cacheMisses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "currencies",
+			Name:      "cache_misses_total",
+			Help:      "Number of the rates and correction rules requested from the database",
+		},
+		[]string{"cache"},
+	)
+)
+
+type cacheEntry struct {
+	value interface{}
+	err   error
+	// the value is valid for the requests by the time in [from, until), until is zero for the value without end
+	from      time.Time
+	until     time.Time
+	expiresAt time.Time
+	// generation of the cache the value is fetched in, see memoryCache.generation
+	generation int64
+}
+
+// cacheVersion - version of the cache in db, see invalidateCache
+type cacheVersion struct {
+	Name      string    `bson:"_id"`
+	Version   int64     `bson:"version"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// memoryCache - read-through cache of the values of one kind, bounded by the number of entries and by the TTL.
+// The writes of this process clear the cache, the writes of other processes clear it on the next versions check,
+// the TTL bounds the staleness if the versions can't be checked
+type memoryCache struct {
+	name    string
+	ttl     time.Duration
+	size    int
+	mx      sync.RWMutex
+	entries map[string]*cacheEntry
+	// version of the cache in db the entries are loaded after
+	version int64
+	// incremented on each clear of the entries, so the value fetched before the clear is not stored after it
+	generation int64
+}
+
+func newMemoryCache(name string, ttl time.Duration, size int) *memoryCache {
+	return &memoryCache{
+		name:    name,
+		ttl:     ttl,
+		size:    size,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+func (c *memoryCache) enabled() bool {
+	return c.ttl > 0 && c.size > 0
+}
+
+// get - returns not expired entry of the key valid for the time
+func (c *memoryCache) get(key string, t time.Time) (*cacheEntry, bool) {
+	if !c.enabled() {
+		return nil, false
+	}
+
+	c.mx.RLock()
+	e, ok := c.entries[key]
+	c.mx.RUnlock()
+
+	if !ok || time.Now().After(e.expiresAt) || t.Before(e.from) || (!e.until.IsZero() && !t.Before(e.until)) {
+		cacheMisses.WithLabelValues(c.name).Inc()
+		return nil, false
+	}
+
+	cacheHits.WithLabelValues(c.name).Inc()
+	return e, true
+}
+
+// getGeneration - returns the generation of the cache, it must be taken before the value of the entry is fetched
+func (c *memoryCache) getGeneration() int64 {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+	return c.generation
+}
+
+// set - stores the entry of the key, the entry valid from the earlier time doesn't replace the stored one,
+// so the requests by past dates don't evict the current values. The entry fetched before the cache was cleared
+// is skipped, it may be stale
+func (c *memoryCache) set(key string, e *cacheEntry) {
+	if !c.enabled() {
+		return
+	}
+
+	e.expiresAt = time.Now().Add(c.ttl)
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if e.generation != c.generation {
+		return
+	}
+
+	if stored, ok := c.entries[key]; ok && e.from.Before(stored.from) && time.Now().Before(stored.expiresAt) {
+		return
+	}
+
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.size {
+		// evict an arbitrary entry, the map iteration order is random
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+
+	c.entries[key] = e
+}
+
+func (c *memoryCache) clear() {
+	c.mx.Lock()
+	c.entries = make(map[string]*cacheEntry)
+	c.generation++
+	c.mx.Unlock()
+}
+
+// syncVersion - clears the cache if the version in db differs from the version the entries are loaded after
+func (c *memoryCache) syncVersion(version int64) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.version == version {
+		return
+	}
+
+	c.entries = make(map[string]*cacheEntry)
+	c.version = version
+	c.generation++
+}
+
+// invalidateCache - clears the cache of this process and increments the version of the cache in db,
+// so other processes clear their caches on the next versions check
+func (s *Service) invalidateCache(c *memoryCache) {
+	c.clear()
+
+	change := mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"version": 1}, "$set": bson.M{"updated_at": time.Now()}},
+		Upsert:    true,
+		ReturnNew: true,
+	}
+
+	res := &cacheVersion{}
+	_, err := s.db.Collection(collectionNameCacheVersions).FindId(c.name).Apply(change, res)
+	if err != nil {
+		zap.L().Error(
+			errorCacheVersionUpdate,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCacheVersions),
+			zap.String("cache", c.name),
+		)
+		return
+	}
+
+	c.syncVersion(res.Version)
+}
+
+// checkCacheVersions - clears the caches changed by other processes
+func (s *Service) checkCacheVersions() error {
+	var items []*cacheVersion
+
+	err := s.db.Collection(collectionNameCacheVersions).Find(nil).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorCacheVersionsCheck,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCacheVersions),
+		)
+		return err
+	}
+
+	for _, item := range items {
+		for _, c := range []*memoryCache{s.ratesCache, s.rulesCache} {
+			if c.name == item.Name {
+				c.syncVersion(item.Version)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) startCacheVersionsCheck() {
+	if s.getConfig().CacheTtl <= 0 || s.getConfig().CacheVersionsCheckInterval <= 0 {
+		return
+	}
+
+	s.cacheVersionsStop = make(chan bool)
+
+	go func(stop <-chan bool) {
+		ticker := time.NewTicker(time.Duration(s.getConfig().CacheVersionsCheckInterval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				_ = s.checkCacheVersions()
+			case <-stop:
+				return
+			}
+		}
+	}(s.cacheVersionsStop)
+}
+
+// getCachedRate - returns direct or cross rate from the cache or from the database,
+// the rate is copied to res, so the caller may change it. The current rate is cached until the effective date
+// of the nearest rate published in advance, like the CBRF rates for tomorrow, so that rate is applied in time
+func (s *Service) getCachedRate(
+	collectionRatesNameSuffix string,
+	from string,
+	to string,
+	query bson.M,
+	source string,
+	res *currencies.RateData,
+) error {
+	key := fmt.Sprintf("%s|%s|%s%s|%v", collectionRatesNameSuffix, source, from, to, query)
+
+	t := time.Now()
+
+	if e, ok := s.ratesCache.get(key, t); ok {
+		proto.Reset(res)
+		proto.Merge(res, e.value.(*currencies.RateData))
+		return nil
+	}
+
+	generation := s.ratesCache.getGeneration()
+	err := s.getDirectRate(collectionRatesNameSuffix, from+to, s.copyQuery(query), source, res)
+
+	// there is no rate for requested pair,
+	// try to calculate the cross rate through the pivot currencies
+	if err == mgo.ErrNotFound {
+		err = s.getCrossRate(collectionRatesNameSuffix, from, to, query, source, res)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// the rate by date doesn't change, the rates of that date are never published in advance
+	var until time.Time
+	if _, ok := query["effective_date"]; !ok {
+		if until, err = s.getRateBoundary(collectionRatesNameSuffix, from, to, query, source, t); err != nil {
+			return nil
+		}
+	}
+
+	s.ratesCache.set(key, &cacheEntry{value: proto.Clone(res), until: until, generation: generation})
+
+	return nil
+}
+
+// getRateBoundary - returns the effective date of the nearest rate after passed time of the pair
+// or of the legs of its cross rate, zero time if there is no such rate
+func (s *Service) getRateBoundary(
+	collectionRatesNameSuffix string,
+	from string,
+	to string,
+	query bson.M,
+	source string,
+	date time.Time,
+) (time.Time, error) {
+	var until time.Time
+
+	cName, err := s.getCollectionName(collectionRatesNameSuffix)
+	if err != nil {
+		return until, err
+	}
+
+	pairs := []string{from + to}
+	for _, pivot := range s.getCrossRatesPivots(collectionRatesNameSuffix, source) {
+		pairs = append(pairs, from+pivot, pivot+to)
+	}
+
+	q := s.copyQuery(query)
+	q["pair"] = bson.M{"$in": pairs}
+	q["effective_date"] = bson.M{"$gt": date}
+	if collectionRatesNameSuffix == currencies.RateTypeCentralbanks {
+		q["source"] = strings.ToUpper(source)
+	}
+
+	next := &currencies.RateData{}
+	err = s.db.Collection(cName).Find(q).Sort("effective_date").Limit(1).One(next)
+	if err == mgo.ErrNotFound {
+		return until, nil
+	}
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, q),
+		)
+		return until, err
+	}
+
+	return ptypes.Timestamp(next.EffectiveDate)
+}
+
+// getCachedCorrectionRule - returns correction rule effective at passed time from the cache or from the database.
+// The found rule is cached until the end of its validity or the start of the nearest scheduled rule,
+// so the scheduled rules are applied in time
+func (s *Service) getCachedCorrectionRule(
+	rateType, exchangeDirection, merchantId string,
+	date time.Time,
+) (*currencies.CorrectionRule, error) {
+	key := rateType + "|" + exchangeDirection + "|" + merchantId
+
+	if e, ok := s.rulesCache.get(key, date); ok {
+		if e.err != nil {
+			return nil, e.err
+		}
+		return proto.Clone(e.value.(*currencies.CorrectionRule)).(*currencies.CorrectionRule), nil
+	}
+
+	generation := s.rulesCache.getGeneration()
+	rule, err := s.getCorrectionRule(rateType, exchangeDirection, merchantId, date)
+	if err != nil && err != mgo.ErrNotFound {
+		return nil, err
+	}
+
+	until, berr := s.getCorrectionRuleBoundary(rateType, exchangeDirection, merchantId, date, rule)
+	if berr == nil {
+		e := &cacheEntry{err: err, from: date, until: until, generation: generation}
+		if rule != nil {
+			e.value = proto.Clone(rule)
+		}
+		s.rulesCache.set(key, e)
+	}
+
+	return rule, err
+}
+
+// getCorrectionRuleBoundary - returns the nearest time after passed one when another rule may become effective:
+// the end of the rule or the start of the nearest scheduled rule, zero time if there is no such time
+func (s *Service) getCorrectionRuleBoundary(
+	rateType, exchangeDirection, merchantId string,
+	date time.Time,
+	rule *currencies.CorrectionRule,
+) (time.Time, error) {
+	var until time.Time
+
+	query := bson.M{
+		"rate_type":          rateType,
+		"exchange_direction": exchangeDirection,
+		"merchant_id":        bson.M{"$in": []string{merchantId, ""}},
+		"valid_from":         bson.M{"$gt": date},
+	}
+
+	next := &currencies.CorrectionRule{}
+	err := s.db.Collection(collectionNameCorrectionRules).Find(query).Sort("valid_from").Limit(1).One(next)
+	if err != nil && err != mgo.ErrNotFound {
+		return until, err
+	}
+
+	if err == nil {
+		if until, err = ptypes.Timestamp(next.ValidFrom); err != nil {
+			return until, err
+		}
+	}
+
+	if rule != nil && rule.ValidTo != nil {
+		validTo, err := ptypes.Timestamp(rule.ValidTo)
+		if err != nil {
+			return until, err
+		}
+		if until.IsZero() || validTo.Before(until) {
+			until = validTo
+		}
+	}
+
+	return until, nil
+}
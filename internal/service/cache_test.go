@@ -0,0 +1,190 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_getRate() {
+	hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheNameRates))
+	misses := testutil.ToFloat64(cacheMisses.WithLabelValues(cacheNameRates))
+
+	rd := &currencies.RateData{}
+	err := suite.service.getRate(currencies.RateTypeOxr, "USD", "RUB", nil, "", rd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r)
+
+	// the cached rate is copied, so the changes of the result don't affect the cache
+	rd.Rate = 1
+
+	rd = &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeOxr, "USD", "RUB", nil, "", rd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r)
+	assert.Equal(suite.T(), testutil.ToFloat64(cacheHits.WithLabelValues(cacheNameRates)), hits+1)
+	assert.Equal(suite.T(), testutil.ToFloat64(cacheMisses.WithLabelValues(cacheNameRates)), misses+1)
+
+	// saving of new rates invalidates the cache
+	err = suite.service.saveRates(collectionRatesNameSuffixOxr, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r + 1, Source: oxrSource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
+
+	rd = &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeOxr, "USD", "RUB", nil, "", rd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r+1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_getRateBoundary() {
+	now := time.Now()
+
+	until, err := suite.service.getRateBoundary(currencies.RateTypeCentralbanks, "USD", "RUB", bson.M{}, cbrfSource, now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), until.IsZero())
+
+	// CBRF publishes the rates for tomorrow, the current rate is changed at their effective date
+	tomorrow := now.AddDate(0, 0, 1).Truncate(time.Second)
+	rates := []interface{}{&currencies.RateData{Pair: "EURRUB", Rate: r, Source: cbrfSource, Volume: 1}}
+	err = suite.service.setRatesEffectiveDate(rates, tomorrow)
+	assert.NoError(suite.T(), err)
+	err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	assert.NoError(suite.T(), err)
+
+	// the rate of the pivot leg changes the cross rate too
+	until, err = suite.service.getRateBoundary(currencies.RateTypeCentralbanks, "GBP", "RUB", bson.M{}, cbrfSource, now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), until.Equal(tomorrow))
+
+	until, err = suite.service.getRateBoundary(currencies.RateTypeCentralbanks, "GBP", "RUB", bson.M{}, cbeuSource, now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), until.IsZero())
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_getCachedCorrectionRule() {
+	hits := testutil.ToFloat64(cacheHits.WithLabelValues(cacheNameCorrectionRules))
+
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  1,
+	})
+	assert.NoError(suite.T(), err)
+
+	nextMonth, err := ptypes.TimestampProto(time.Now().AddDate(0, 1, 0))
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  5,
+		ValidFrom:         nextMonth,
+	})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCachedCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(1))
+
+	rule, err = suite.service.getCachedCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(1))
+	assert.Equal(suite.T(), testutil.ToFloat64(cacheHits.WithLabelValues(cacheNameCorrectionRules)), hits+1)
+
+	// the cached rule is not used after the start of the scheduled rule
+	rule, err = suite.service.getCachedCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now().AddDate(0, 1, 1))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(5))
+
+	// adding of a rule invalidates the cache
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  2,
+	})
+	assert.NoError(suite.T(), err)
+
+	rule, err = suite.service.getCachedCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(2))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_memoryCache() {
+	c := newMemoryCache(cacheNameRates, time.Minute, 2)
+	c.set("a", &cacheEntry{value: 1})
+	c.set("b", &cacheEntry{value: 2})
+	c.set("c", &cacheEntry{value: 3})
+	assert.Len(suite.T(), c.entries, 2)
+
+	e, ok := c.get("c", time.Time{})
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), e.value, 3)
+
+	// the entry valid from the earlier time doesn't replace the current one
+	t := time.Now()
+	c.set("d", &cacheEntry{value: 4, from: t})
+	c.set("d", &cacheEntry{value: 5, from: t.Add(-time.Hour)})
+	e, ok = c.get("d", t)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), e.value, 4)
+
+	_, ok = c.get("d", t.Add(-time.Hour))
+	assert.False(suite.T(), ok)
+
+	c.clear()
+	_, ok = c.get("c", time.Time{})
+	assert.False(suite.T(), ok)
+
+	// the value fetched before the versions sync is not stored after it
+	generation := c.getGeneration()
+	c.syncVersion(1)
+	c.set("e", &cacheEntry{value: 6, generation: generation})
+	_, ok = c.get("e", time.Time{})
+	assert.False(suite.T(), ok)
+
+	c.set("e", &cacheEntry{value: 6, generation: c.getGeneration()})
+	_, ok = c.get("e", time.Time{})
+	assert.True(suite.T(), ok)
+
+	disabled := newMemoryCache(cacheNameRates, 0, 2)
+	disabled.set("a", &cacheEntry{value: 1})
+	_, ok = disabled.get("a", time.Time{})
+	assert.False(suite.T(), ok)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_checkCacheVersions() {
+	rd := &currencies.RateData{}
+	err := suite.service.getRate(currencies.RateTypeOxr, "USD", "RUB", nil, "", rd)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), suite.service.ratesCache.entries)
+
+	// the versions are not changed by other processes
+	err = suite.service.checkCacheVersions()
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), suite.service.ratesCache.entries)
+
+	// the rates are saved by other process
+	_, err = suite.service.db.Collection(collectionNameCacheVersions).UpsertId(
+		cacheNameRates,
+		bson.M{"$inc": bson.M{"version": 1}, "$set": bson.M{"updated_at": time.Now()}},
+	)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.checkCacheVersions()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), suite.service.ratesCache.entries)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCache_invalidateCache() {
+	suite.service.invalidateCache(suite.service.rulesCache)
+	suite.service.invalidateCache(suite.service.rulesCache)
+
+	item := &cacheVersion{}
+	err := suite.service.db.Collection(collectionNameCacheVersions).FindId(cacheNameCorrectionRules).One(item)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), item.Version, int64(2))
+	assert.Equal(suite.T(), suite.service.rulesCache.version, int64(2))
+}
@@ -1,37 +1,175 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"time"
 )
 
 const (
-	errorGetCorrection = "can't get correction value"
+	errorGetCorrection             = "can't get correction value"
+	errorSetCorrection             = "can't set correction value"
+	errorCorrectionHistoryNotFound = "correction history not found"
 )
 
 type paysuperCorrection struct {
-	Pair      string    `bson:"pair"`
-	CreatedAt time.Time `bson:"created_at"`
-	Value     float64   `bson:"value"`
+	Id        bson.ObjectId `bson:"_id,omitempty"`
+	Pair      string        `bson:"pair"`
+	CreatedAt time.Time     `bson:"created_at"`
+	Value     float64       `bson:"value"`
+	CreatedBy string        `bson:"created_by,omitempty"`
 }
 
-// GetPaysuperCorrection - returns paysuper correction value for passed pair of currencies
-func (s *Service) GetPaysuperCorrection(pair string) (float64, error) {
-	if !s.isPairExists(pair) {
-		zap.S().Errorw(errorGetCorrection, "error", errorCurrencyPairNotExists, "pair", pair)
-		return 0, errors.New(errorCurrencyPairNotExists)
+// GetPaysuperCorrections - returns current paysuper correction values of all pairs
+func (s *Service) GetPaysuperCorrections(
+	ctx context.Context,
+	req *currencies.EmptyRequest,
+	res *currencies.PaysuperCorrectionsResponse,
+) error {
+	items, err := s.getPaysuperCorrections()
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		res.Items = append(res.Items, s.toPaysuperCorrectionProto(item))
+	}
+
+	return nil
+}
+
+// SetPaysuperCorrection - sets new paysuper correction value for the pair, previous values are kept as history
+func (s *Service) SetPaysuperCorrection(
+	ctx context.Context,
+	req *currencies.PaysuperCorrection,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	if !s.isPairExists(req.Pair) {
+		zap.S().Errorw(errorSetCorrection, "error", errorCurrencyPairNotExists, "req", req)
+		return errors.New(errorCurrencyPairNotExists)
+	}
+
+	item := &paysuperCorrection{
+		Id:        bson.NewObjectId(),
+		Pair:      req.Pair,
+		Value:     req.Value,
+		CreatedAt: time.Now(),
+		CreatedBy: req.CreatedBy,
+	}
+
+	err := s.db.Collection(collectionNamePaysuperCorrections).Insert(item)
+	if err != nil {
+		zap.S().Errorw(errorSetCorrection, "error", err, "item", item)
+		return err
+	}
+
+	zap.S().Infow("Paysuper correction changed", "pair", item.Pair, "value", item.Value, "user", item.CreatedBy)
+
+	return nil
+}
+
+// GetPaysuperCorrectionHistory - returns all correction values of the pair, the latest first
+func (s *Service) GetPaysuperCorrectionHistory(
+	ctx context.Context,
+	req *currencies.PaysuperCorrectionRequest,
+	res *currencies.PaysuperCorrectionsResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
 	}
 
-	query := bson.M{"pair": pair}
+	var items []*paysuperCorrection
+	query := bson.M{"pair": req.Pair}
 
-	res := &paysuperCorrection{}
-	err := s.db.Collection(collectionNamePaysuperCorrections).Find(query).Sort("-_id").Limit(1).One(res)
+	err := s.db.Collection(collectionNamePaysuperCorrections).Find(query).Sort("-_id").All(&items)
 	if err != nil {
-		zap.S().Errorw(errorGetCorrection, "error", err, "pair", pair)
-		return 0, err
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorrections),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
 	}
 
-	return res.Value, nil
+	if len(items) == 0 {
+		return errors.New(errorCorrectionHistoryNotFound)
+	}
+
+	for _, item := range items {
+		res.Items = append(res.Items, s.toPaysuperCorrectionProto(item))
+	}
+
+	return nil
+}
+
+// getPaysuperCorrections - returns the latest correction of each pair
+func (s *Service) getPaysuperCorrections() ([]*paysuperCorrection, error) {
+	pipeline := []bson.M{
+		{"$sort": bson.M{"_id": -1}},
+		{
+			"$group": bson.M{
+				"_id":        "$pair",
+				"id":         bson.M{"$first": "$_id"},
+				"value":      bson.M{"$first": "$value"},
+				"created_at": bson.M{"$first": "$created_at"},
+				"created_by": bson.M{"$first": "$created_by"},
+			},
+		},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	var items []*struct {
+		Pair      string        `bson:"_id"`
+		Id        bson.ObjectId `bson:"id"`
+		Value     float64       `bson:"value"`
+		CreatedAt time.Time     `bson:"created_at"`
+		CreatedBy string        `bson:"created_by"`
+	}
+
+	err := s.db.Collection(collectionNamePaysuperCorrections).Pipe(pipeline).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorGetCorrection,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorrections),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return nil, err
+	}
+
+	res := make([]*paysuperCorrection, len(items))
+	for i, item := range items {
+		res[i] = &paysuperCorrection{
+			Id:        item.Id,
+			Pair:      item.Pair,
+			Value:     item.Value,
+			CreatedAt: item.CreatedAt,
+			CreatedBy: item.CreatedBy,
+		}
+	}
+
+	return res, nil
+}
+
+func (s *Service) toPaysuperCorrectionProto(item *paysuperCorrection) *currencies.PaysuperCorrection {
+	createdAt, _ := ptypes.TimestampProto(item.CreatedAt)
+	return &currencies.PaysuperCorrection{
+		Id:        item.Id.Hex(),
+		Pair:      item.Pair,
+		Value:     item.Value,
+		CreatedAt: createdAt,
+		CreatedBy: item.CreatedBy,
+	}
 }
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionPaysuper_SetPaysuperCorrection_Ok() {
+	req := &currencies.PaysuperCorrection{Pair: "USDRUB", Value: 1.5, CreatedBy: "admin"}
+	err := suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	req = &currencies.PaysuperCorrection{Pair: "USDRUB", Value: -0.5, CreatedBy: "finance"}
+	err = suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	req = &currencies.PaysuperCorrection{Pair: "EURRUB", Value: 2, CreatedBy: "admin"}
+	err = suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	res := &currencies.PaysuperCorrectionsResponse{}
+	err = suite.service.GetPaysuperCorrections(context.TODO(), &currencies.EmptyRequest{}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 2)
+	assert.Equal(suite.T(), res.Items[0].Pair, "EURRUB")
+	assert.Equal(suite.T(), res.Items[1].Pair, "USDRUB")
+	assert.Equal(suite.T(), res.Items[1].Value, -0.5)
+	assert.Equal(suite.T(), res.Items[1].CreatedBy, "finance")
+
+	history := &currencies.PaysuperCorrectionsResponse{}
+	err = suite.service.GetPaysuperCorrectionHistory(context.TODO(), &currencies.PaysuperCorrectionRequest{Pair: "USDRUB"}, history)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), history.Items, 2)
+	assert.Equal(suite.T(), history.Items[0].Value, -0.5)
+	assert.Equal(suite.T(), history.Items[1].Value, 1.5)
+	assert.Equal(suite.T(), history.Items[1].CreatedBy, "admin")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionPaysuper_SetPaysuperCorrection_Fail() {
+	req := &currencies.PaysuperCorrection{Pair: "USDRUB", Value: 1}
+	err := suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	req = &currencies.PaysuperCorrection{Pair: "USDXXX", Value: 1, CreatedBy: "admin"}
+	err = suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyPairNotExists)
+
+	req = &currencies.PaysuperCorrection{Pair: "USDRUB", Value: 101, CreatedBy: "admin"}
+	err = suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	// the correction of -100% zeroes the rate
+	req = &currencies.PaysuperCorrection{Pair: "USDRUB", Value: -100, CreatedBy: "admin"}
+	err = suite.service.SetPaysuperCorrection(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	history := &currencies.PaysuperCorrectionsResponse{}
+	err = suite.service.GetPaysuperCorrectionHistory(context.TODO(), &currencies.PaysuperCorrectionRequest{Pair: "USDRUB"}, history)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionHistoryNotFound)
+}
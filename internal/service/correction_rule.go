@@ -0,0 +1,394 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	correctionRulesDefaultLimit = 100
+
+	// the rate is divided by 1 ± correction percent
+	correctionRuleKindPercent = "percent"
+	// the spread in basis points of the quote currency unit is added to the rate or subtracted from it
+	correctionRuleKindFixedSpread = "fixed_spread"
+	// the correction percent depends on the exchanged amount
+	correctionRuleKindTiered = "tiered"
+
+	basisPointsInUnit = 10000
+
+	// matches any currency in the currency pattern of correction rule, e.g. "*TRY" or "USD*"
+	correctionPairWildcard = "*"
+
+	errorCorrectionRuleUpdateFailed = "correction rule update failed"
+	errorCorrectionRuleKindInvalid  = "correction rule kind invalid"
+	errorCorrectionRuleKindMismatch = "correction rule values do not match its kind"
+	errorCorrectionSpreadInvalid    = "correction spread invalid"
+	errorCorrectionTiersInvalid     = "correction tiers invalid"
+)
+
+// ListCorrectionRules - returns correction rules matched the filter, the latest first
+func (s *Service) ListCorrectionRules(
+	ctx context.Context,
+	req *currencies.ListCorrectionRulesRequest,
+	res *currencies.CorrectionRulesResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	query := bson.M{}
+	if req.RateType != "" {
+		query["rate_type"] = req.RateType
+	}
+	if req.ExchangeDirection != "" {
+		query["exchange_direction"] = req.ExchangeDirection
+	}
+	if req.Common {
+		query["merchant_id"] = ""
+	} else if req.MerchantId != "" {
+		query["merchant_id"] = req.MerchantId
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = correctionRulesDefaultLimit
+	}
+
+	count, err := s.db.Collection(collectionNameCorrectionRules).Find(query).Count()
+	if err == nil {
+		err = s.db.Collection(collectionNameCorrectionRules).Find(query).
+			Sort("-valid_from", "-_id").
+			Skip(int(req.Offset)).
+			Limit(limit).
+			All(&res.Items)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCorrectionRules),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	res.Count = int32(count)
+
+	return nil
+}
+
+// DeleteMerchantCorrectionRule - ends validity of merchant's rules for passed rate type and direction,
+// so the merchant falls back to the common rule. The rules are kept to be applied to the requests by past dates
+func (s *Service) DeleteMerchantCorrectionRule(
+	ctx context.Context,
+	req *currencies.MerchantCorrectionRuleRequest,
+	res *currencies.EmptyResponse,
+) error {
+	if req.MerchantId == "" {
+		zap.S().Errorw(errorMerchantIdRequired, "req", req)
+		return errMerchantIdRequired
+	}
+
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	t := time.Now()
+	query := bson.M{
+		"rate_type":          req.RateType,
+		"exchange_direction": req.ExchangeDirection,
+		"merchant_id":        req.MerchantId,
+		"$or":                []bson.M{{"valid_to": nil}, {"valid_to": bson.M{"$gt": t}}},
+	}
+
+	var rules []*currencies.CorrectionRule
+	err := s.db.Collection(collectionNameCorrectionRules).Find(query).All(&rules)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCorrectionRules),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	if len(rules) == 0 {
+		zap.S().Errorw(errorCorrectionRuleNotFound, "req", req)
+		return errors.New(errorCorrectionRuleNotFound)
+	}
+
+	for _, rule := range rules {
+		// the rules scheduled for the future end at their start, so they are never applied
+		validTo := t
+		if validFrom, err := ptypes.Timestamp(rule.ValidFrom); err == nil && validFrom.After(t) {
+			validTo = validFrom
+		}
+
+		set := bson.M{"$set": bson.M{"valid_to": validTo}}
+		err = s.db.Collection(collectionNameCorrectionRules).Update(s.getCorrectionRuleIdQuery(rule.Id), set)
+		if err != nil {
+			zap.L().Error(
+				errorCorrectionRuleUpdateFailed,
+				zap.Error(err),
+				zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCorrectionRules),
+				zap.String("id", rule.Id),
+				zap.Any(pkg.ErrorDatabaseFieldSet, set),
+			)
+			return err
+		}
+	}
+
+	s.invalidateCache(s.rulesCache)
+
+	zap.S().Infow("Merchant correction rules deleted", "merchantId", req.MerchantId, "rateType", req.RateType,
+		"exchangeDirection", req.ExchangeDirection, "rules", len(rules))
+
+	return nil
+}
+
+// RevertCorrectionRule - makes the previous rule effective again, the copy of the rule is added as a new rule
+// valid from now, so the history of the rules is kept
+func (s *Service) RevertCorrectionRule(
+	ctx context.Context,
+	req *currencies.RevertCorrectionRuleRequest,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	if !bson.IsObjectIdHex(req.Id) {
+		zap.S().Errorw(errorCorrectionRuleNotFound, "req", req)
+		return errors.New(errorCorrectionRuleNotFound)
+	}
+
+	query := s.getCorrectionRuleIdQuery(req.Id)
+
+	rule := &currencies.CorrectionRule{}
+	err := s.db.Collection(collectionNameCorrectionRules).Find(query).One(rule)
+
+	if err == mgo.ErrNotFound {
+		zap.S().Errorw(errorCorrectionRuleNotFound, "req", req)
+		return errors.New(errorCorrectionRuleNotFound)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCorrectionRules),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	rule.ValidFrom = nil
+	rule.ValidTo = nil
+
+	return s.addCorrectionRule(rule)
+}
+
+// getCorrectionRuleIdQuery - matches the rule by the hex of its id, the ids of the rules saved before
+// are ObjectIds or the hex strings, so both are matched
+func (s *Service) getCorrectionRuleIdQuery(id string) bson.M {
+	ids := []interface{}{id}
+	if bson.IsObjectIdHex(id) {
+		ids = append(ids, bson.ObjectIdHex(id))
+	}
+
+	return bson.M{"_id": bson.M{"$in": ids}}
+}
+
+// validateCorrectionRuleKind - checks the values of the rule by its kind, the values of other kinds must be empty
+func (s *Service) validateCorrectionRuleKind(rule *currencies.CorrectionRule) error {
+	hasPercent := rule.CommonCorrection != 0 || len(rule.PairCorrection) > 0
+	hasSpread := rule.CommonSpread != 0 || len(rule.PairSpread) > 0
+	hasTiers := len(rule.Tiers) > 0
+
+	switch rule.Kind {
+
+	case correctionRuleKindPercent:
+		if hasSpread || hasTiers {
+			return errors.New(errorCorrectionRuleKindMismatch)
+		}
+		if !s.isCorrectionPercentValid(rule.CommonCorrection) {
+			return errors.New(errorCorrectionPercentInvalid)
+		}
+		for pair, val := range rule.PairCorrection {
+			if !s.isCorrectionPairValid(pair) {
+				return errors.New(errorCurrencyPairNotExists)
+			}
+			if !s.isCorrectionPercentValid(val) {
+				return errors.New(errorCorrectionPercentInvalid)
+			}
+		}
+
+	case correctionRuleKindFixedSpread:
+		if hasPercent || hasTiers {
+			return errors.New(errorCorrectionRuleKindMismatch)
+		}
+		if rule.CommonSpread < 0 || rule.CommonSpread >= basisPointsInUnit {
+			return errors.New(errorCorrectionSpreadInvalid)
+		}
+		for pair, val := range rule.PairSpread {
+			if !s.isCorrectionPairValid(pair) {
+				return errors.New(errorCurrencyPairNotExists)
+			}
+			if val < 0 || val >= basisPointsInUnit {
+				return errors.New(errorCorrectionSpreadInvalid)
+			}
+		}
+
+	case correctionRuleKindTiered:
+		if hasPercent || hasSpread {
+			return errors.New(errorCorrectionRuleKindMismatch)
+		}
+		if !hasTiers {
+			return errors.New(errorCorrectionTiersInvalid)
+		}
+		bands := make(map[string]bool, len(rule.Tiers))
+		for _, tier := range rule.Tiers {
+			if tier.Pair != "" && !s.isCorrectionPairValid(tier.Pair) {
+				return errors.New(errorCurrencyPairNotExists)
+			}
+			if !s.isCorrectionPercentValid(tier.Correction) {
+				return errors.New(errorCorrectionPercentInvalid)
+			}
+			band := tier.Pair + strconv.FormatFloat(tier.AmountFrom, 'f', -1, 64)
+			if tier.AmountFrom < 0 || bands[band] {
+				return errors.New(errorCorrectionTiersInvalid)
+			}
+			bands[band] = true
+		}
+
+	default:
+		return errors.New(errorCorrectionRuleKindInvalid)
+	}
+
+	return nil
+}
+
+// getCorrectionRuleKind - returns kind of the rule, the rules added before the kinds were introduced are percent ones
+func (s *Service) getCorrectionRuleKind(rule *currencies.CorrectionRule) string {
+	if rule.Kind == "" {
+		return correctionRuleKindPercent
+	}
+	return rule.Kind
+}
+
+// getCorrectionValue - returns correction of the rule for passed pair and amount,
+// in basis points for the fixed_spread rules and in percent for others
+func (s *Service) getCorrectionValue(rule *currencies.CorrectionRule, pair string, amount float64) float64 {
+	switch s.getCorrectionRuleKind(rule) {
+
+	case correctionRuleKindFixedSpread:
+		if val, ok := s.getCorrectionPairValue(rule.PairSpread, pair); ok {
+			return val
+		}
+		return rule.CommonSpread
+
+	case correctionRuleKindTiered:
+		tier := s.getCorrectionTier(rule.Tiers, pair, amount)
+		if tier == nil {
+			return 0
+		}
+		return tier.Correction
+	}
+
+	if val, ok := s.getCorrectionPairValue(rule.PairCorrection, pair); ok {
+		return val
+	}
+	return rule.CommonCorrection
+}
+
+// getCorrectionPairKeys - returns the keys matching the pair in the order of precedence:
+// the exact pair, the pattern of the source currency ("USD*"), the pattern of the target currency ("*TRY")
+func (s *Service) getCorrectionPairKeys(pair string) []string {
+	if len(pair) != 6 {
+		return []string{pair}
+	}
+	return []string{pair, pair[0:3] + correctionPairWildcard, correctionPairWildcard + pair[3:6]}
+}
+
+// getCorrectionPairValue - returns the value of the key with the highest precedence matching the pair
+func (s *Service) getCorrectionPairValue(values map[string]float64, pair string) (float64, bool) {
+	for _, key := range s.getCorrectionPairKeys(pair) {
+		if val, ok := values[key]; ok {
+			return val, true
+		}
+	}
+	return 0, false
+}
+
+// isCorrectionPairValid - checks the key of correction rule is an existing pair or a pattern of a supported currency
+func (s *Service) isCorrectionPairValid(key string) bool {
+	switch {
+	case len(key) == 4 && strings.HasSuffix(key, correctionPairWildcard):
+		return s.isCurrencySupported(key[0:3])
+	case len(key) == 4 && strings.HasPrefix(key, correctionPairWildcard):
+		return s.isCurrencySupported(key[1:4])
+	}
+	return s.isPairExists(key)
+}
+
+// getCorrectionTier - returns the tier with the greatest start of the band not exceeding the amount,
+// only the tiers of the key with the highest precedence matching the pair are used, the tiers without pair otherwise
+func (s *Service) getCorrectionTier(tiers []*currencies.CorrectionTier, pair string, amount float64) *currencies.CorrectionTier {
+	keys := make(map[string]bool, len(tiers))
+	for _, tier := range tiers {
+		keys[tier.Pair] = true
+	}
+
+	tierPair := ""
+	for _, key := range s.getCorrectionPairKeys(pair) {
+		if keys[key] {
+			tierPair = key
+			break
+		}
+	}
+
+	var res *currencies.CorrectionTier
+	for _, tier := range tiers {
+		if tier.Pair != tierPair || tier.AmountFrom > amount {
+			continue
+		}
+		if res == nil || tier.AmountFrom > res.AmountFrom {
+			res = tier
+		}
+	}
+
+	return res
+}
+
+// applyCorrectionSpread - increases the sell rate and decreases the buy rate by the spread in basis points of the rate
+func (s *Service) applyCorrectionSpread(rd *currencies.RateData, exchangeDirection string, value float64) {
+	spread := decimal.NewFromFloat(value).Div(decimal.NewFromInt(basisPointsInUnit))
+	rate := s.getRateDecimal(rd)
+
+	switch exchangeDirection {
+
+	case currencies.ExchangeDirectionSell:
+		rate = rate.Mul(decimal.NewFromInt(1).Add(spread))
+
+	case currencies.ExchangeDirectionBuy:
+		rate = rate.Mul(decimal.NewFromInt(1).Sub(spread))
+	}
+
+	s.setRateDecimal(rd, rate)
+}
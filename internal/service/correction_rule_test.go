@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_ListCorrectionRules() {
+	merchantId := bson.NewObjectId().Hex()
+
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 2, MerchantId: merchantId})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 3, MerchantId: merchantId})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionSell, CommonCorrection: 4, MerchantId: merchantId})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.ListCorrectionRulesRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		MerchantId:        merchantId,
+	}
+	res := &currencies.CorrectionRulesResponse{}
+	err = suite.service.ListCorrectionRules(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), res.Count, 2)
+	assert.Len(suite.T(), res.Items, 2)
+	assert.Equal(suite.T(), res.Items[0].CommonCorrection, float64(3))
+
+	req.Limit = 1
+	req.Offset = 1
+	res = &currencies.CorrectionRulesResponse{}
+	err = suite.service.ListCorrectionRules(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.EqualValues(suite.T(), res.Count, 2)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Items[0].CommonCorrection, float64(2))
+
+	// the rate type filters out the common stock rule of the test defaults
+	res = &currencies.CorrectionRulesResponse{}
+	err = suite.service.ListCorrectionRules(context.TODO(), &currencies.ListCorrectionRulesRequest{RateType: currencies.RateTypeOxr, Common: true}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Items[0].CommonCorrection, float64(1))
+
+	req = &currencies.ListCorrectionRulesRequest{RateType: "bla-bla"}
+	err = suite.service.ListCorrectionRules(context.TODO(), req, res)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_DeleteMerchantCorrectionRule() {
+	merchantId := bson.NewObjectId().Hex()
+
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 2, MerchantId: merchantId})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(2))
+
+	req := &currencies.MerchantCorrectionRuleRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		MerchantId:        merchantId,
+	}
+	err = suite.service.DeleteMerchantCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	// the merchant falls back to the common rule
+	rule, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(1))
+	assert.Equal(suite.T(), rule.MerchantId, "")
+
+	// the rule is kept for the requests by past dates
+	rule, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now().Add(-time.Millisecond))
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.DeleteMerchantCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionRuleNotFound)
+
+	req.MerchantId = ""
+	err = suite.service.DeleteMerchantCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorMerchantIdRequired)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_RevertCorrectionRule() {
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1})
+	assert.NoError(suite.T(), err)
+
+	previous, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 2})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.RevertCorrectionRuleRequest{Id: previous.Id}
+	err = suite.service.RevertCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(1))
+	assert.NotEqual(suite.T(), rule.Id, previous.Id)
+
+	req.Id = bson.NewObjectId().Hex()
+	err = suite.service.RevertCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionRuleNotFound)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_RevertCorrectionRule_ObjectId() {
+	// the rules created by migrations have ObjectId ids
+	id := bson.NewObjectId()
+	err := suite.service.db.Collection(collectionNameCorrectionRules).Insert(bson.M{
+		"_id":                id,
+		"rate_type":          currencies.RateTypeOxr,
+		"exchange_direction": currencies.ExchangeDirectionBuy,
+		"common_correction":  3,
+		"merchant_id":        "",
+		"valid_from":         time.Now().AddDate(0, 0, -1),
+	})
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 2})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.RevertCorrectionRuleRequest{Id: id.Hex()}
+	err = suite.service.RevertCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(3))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_StringId() {
+	merchantId := bson.NewObjectId().Hex()
+
+	// the rules saved before may have the hex of the id stored as a string
+	id := bson.NewObjectId().Hex()
+	err := suite.service.db.Collection(collectionNameCorrectionRules).Insert(bson.M{
+		"_id":                id,
+		"rate_type":          currencies.RateTypeOxr,
+		"exchange_direction": currencies.ExchangeDirectionBuy,
+		"common_correction":  3,
+		"merchant_id":        merchantId,
+		"valid_from":         time.Now().AddDate(0, 0, -1),
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.MerchantCorrectionRuleRequest{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		MerchantId:        merchantId,
+	}
+	err = suite.service.DeleteMerchantCorrectionRule(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	_, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
+	assert.Error(suite.T(), err)
+
+	err = suite.service.RevertCorrectionRule(context.TODO(), &currencies.RevertCorrectionRuleRequest{Id: id}, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(3))
+	assert.NotEqual(suite.T(), rule.Id, id)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_FixedSpread() {
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		Kind:              correctionRuleKindFixedSpread,
+		CommonSpread:      100,
+	})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+		Kind:              correctionRuleKindFixedSpread,
+		PairSpread:        map[string]float64{"USDRUB": 50},
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.ExchangeCurrencyCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeOxr,
+		Amount:            100,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+	}
+	res := &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.OriginalRate, r)
+	assert.Equal(suite.T(), res.ExchangeRateDecimal, "63.984086")
+	assert.Equal(suite.T(), res.Correction, float64(100))
+	assert.Equal(suite.T(), res.CorrectionKind, correctionRuleKindFixedSpread)
+
+	req.ExchangeDirection = currencies.ExchangeDirectionSell
+	res = &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangeRateDecimal, "64.954557")
+	assert.Equal(suite.T(), res.Correction, float64(50))
+
+	// the spread is relative to the rate, so it's applied to the rates of any scale
+	rd := &currencies.RateData{Pair: "USDRUB", Rate: 0.5}
+	suite.service.applyCorrectionSpread(rd, currencies.ExchangeDirectionBuy, 100)
+	assert.Equal(suite.T(), rd.Rate, 0.495)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_Tiered() {
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		Kind:              correctionRuleKindTiered,
+		Tiers: []*currencies.CorrectionTier{
+			{AmountFrom: 1000, Correction: 2},
+			{AmountFrom: 0, Correction: 1},
+		},
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.ExchangeCurrencyCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeOxr,
+		Amount:            100,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+	}
+	res := &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangeRateDecimal, "63.991486")
+	assert.Equal(suite.T(), res.Correction, float64(1))
+	assert.Equal(suite.T(), res.CorrectionKind, correctionRuleKindTiered)
+
+	req.Amount = 5000
+	res = &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangeRateDecimal, "63.364118")
+	assert.Equal(suite.T(), res.Correction, float64(2))
+
+	tiers := []*currencies.CorrectionTier{
+		{Pair: "USDRUB", AmountFrom: 500, Correction: 3},
+		{AmountFrom: 0, Correction: 1},
+	}
+	// the tiers of the pair take priority over the tiers for all pairs
+	assert.Nil(suite.T(), suite.service.getCorrectionTier(tiers, "USDRUB", 100))
+	assert.Equal(suite.T(), suite.service.getCorrectionTier(tiers, "USDRUB", 500).Correction, float64(3))
+	assert.Equal(suite.T(), suite.service.getCorrectionTier(tiers, "EURRUB", 500).Correction, float64(1))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_addCorrectionRule_KindFail() {
+	rule := &currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		Kind:              "bla-bla",
+	}
+	err := suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionRuleKindInvalid)
+
+	rule.Kind = correctionRuleKindFixedSpread
+	rule.CommonCorrection = 1
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionRuleKindMismatch)
+
+	rule.CommonCorrection = 0
+	rule.PairSpread = map[string]float64{"USDRUB": -1}
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionSpreadInvalid)
+
+	rule.PairSpread = nil
+	rule.CommonSpread = basisPointsInUnit
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionSpreadInvalid)
+
+	rule.CommonSpread = 0
+	rule.Kind = correctionRuleKindTiered
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionTiersInvalid)
+
+	rule.Tiers = []*currencies.CorrectionTier{{AmountFrom: 100, Correction: 1}, {AmountFrom: 100, Correction: 2}}
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionTiersInvalid)
+
+	rule.Tiers = []*currencies.CorrectionTier{{Pair: "USDBLA", Correction: 1}}
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyPairNotExists)
+
+	rule.Tiers = []*currencies.CorrectionTier{{Correction: 101}}
+	err = suite.service.addCorrectionRule(rule)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionPercentInvalid)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_PairPatterns() {
+	rule := &currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  1,
+		PairCorrection: map[string]float64{
+			"USDRUB": 2,
+			"USD*":   3,
+			"*RUB":   4,
+		},
+	}
+	err := suite.service.addCorrectionRule(rule)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), suite.service.getCorrectionValue(rule, "USDRUB", 0), float64(2))
+	assert.Equal(suite.T(), suite.service.getCorrectionValue(rule, "USDEUR", 0), float64(3))
+	assert.Equal(suite.T(), suite.service.getCorrectionValue(rule, "EURRUB", 0), float64(4))
+	assert.Equal(suite.T(), suite.service.getCorrectionValue(rule, "EURUSD", 0), float64(1))
+
+	req := &currencies.ExchangeCurrencyCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeOxr,
+		Amount:            100,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+	}
+	res := &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Correction, float64(2))
+
+	tiers := []*currencies.CorrectionTier{
+		{Pair: "*RUB", Correction: 3},
+		{Correction: 1},
+	}
+	assert.Equal(suite.T(), suite.service.getCorrectionTier(tiers, "USDRUB", 100).Correction, float64(3))
+	assert.Equal(suite.T(), suite.service.getCorrectionTier(tiers, "USDEUR", 100).Correction, float64(1))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorrectionRule_PairPatternsFail() {
+	for _, key := range []string{"*", "**", "BLA*", "*BLA", "US*D", "USD**"} {
+		err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+			RateType:          currencies.RateTypeOxr,
+			ExchangeDirection: currencies.ExchangeDirectionBuy,
+			PairCorrection:    map[string]float64{key: 1},
+		})
+		assert.Error(suite.T(), err, key)
+		assert.Equal(suite.T(), err.Error(), errorCurrencyPairNotExists)
+	}
+}
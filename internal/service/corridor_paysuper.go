@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	corridorActionClamp  = "clamp"
+	corridorActionReject = "reject"
+
+	errorCorridorActionInvalid = "corridor action invalid"
+	errorCorridorNotFound      = "corridor not found"
+	errorCorridorUpdateFailed  = "corridor update failed"
+	errorCorridorRateOutside   = "paysuper rate is outside of the corridor"
+)
+
+// errCorridorRateOutside - the rate of the pair is refused, the rates of other pairs are saved
+var errCorridorRateOutside = errors.New(errorCorridorRateOutside)
+
+var corridorActions = map[string]bool{
+	corridorActionClamp:  true,
+	corridorActionReject: true,
+}
+
+// paysuperCorridor - band around the OXR rate the paysuper rate of the pair must be within,
+// the deleted corridor is kept with the user who deleted it till the corridor of the pair is set again
+type paysuperCorridor struct {
+	Id        bson.ObjectId `bson:"_id"`
+	Pair      string        `bson:"pair"`
+	Value     float64       `bson:"value"`
+	UpdatedAt time.Time     `bson:"updated_at"`
+	UpdatedBy string        `bson:"updated_by"`
+	DeletedAt *time.Time    `bson:"deleted_at,omitempty"`
+	DeletedBy string        `bson:"deleted_by,omitempty"`
+}
+
+// GetPaysuperCorridors - returns corridors of all pairs that have them
+func (s *Service) GetPaysuperCorridors(
+	ctx context.Context,
+	req *currencies.EmptyRequest,
+	res *currencies.CorrectionCorridorsResponse,
+) error {
+	var items []*paysuperCorridor
+
+	err := s.db.Collection(collectionNamePaysuperCorridors).Find(bson.M{"deleted_at": nil}).Sort("pair").All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorridors),
+		)
+		return err
+	}
+
+	for _, item := range items {
+		updatedAt, _ := ptypes.TimestampProto(item.UpdatedAt)
+		res.Items = append(res.Items, &currencies.CorrectionCorridor{
+			Pair:      item.Pair,
+			Value:     item.Value,
+			UpdatedAt: updatedAt,
+			UpdatedBy: item.UpdatedBy,
+		})
+	}
+
+	return nil
+}
+
+// SetPaysuperCorridor - creates or updates corridor of the pair
+func (s *Service) SetPaysuperCorridor(
+	ctx context.Context,
+	req *currencies.CorrectionCorridor,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	if !s.isPairExists(req.Pair) {
+		zap.S().Errorw(errorCorridorUpdateFailed, "error", errorCurrencyPairNotExists, "req", req)
+		return errors.New(errorCurrencyPairNotExists)
+	}
+
+	query := bson.M{"pair": req.Pair}
+	set := bson.M{
+		"$set":         bson.M{"value": req.Value, "updated_at": time.Now(), "updated_by": req.UpdatedBy},
+		"$unset":       bson.M{"deleted_at": "", "deleted_by": ""},
+		"$setOnInsert": bson.M{"_id": bson.NewObjectId()},
+	}
+
+	_, err := s.db.Collection(collectionNamePaysuperCorridors).Upsert(query, set)
+	if err != nil {
+		zap.L().Error(
+			errorCorridorUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorridors),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+		return err
+	}
+
+	zap.S().Infow("Paysuper corridor changed", "pair", req.Pair, "value", req.Value, "user", req.UpdatedBy)
+
+	return nil
+}
+
+// DeletePaysuperCorridor - removes corridor of the pair, the default corridor is applied to the pair after that
+func (s *Service) DeletePaysuperCorridor(
+	ctx context.Context,
+	req *currencies.CorrectionCorridorRequest,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	query := bson.M{"pair": req.Pair, "deleted_at": nil}
+	set := bson.M{"$set": bson.M{"deleted_at": time.Now(), "deleted_by": req.UserId}}
+	err := s.db.Collection(collectionNamePaysuperCorridors).Update(query, set)
+
+	if err == mgo.ErrNotFound {
+		zap.S().Errorw(errorCorridorNotFound, "req", req)
+		return errors.New(errorCorridorNotFound)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			errorCorridorUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorridors),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+		return err
+	}
+
+	zap.S().Infow("Paysuper corridor deleted", "pair", req.Pair, "user", req.UserId)
+
+	return nil
+}
+
+// getPaysuperCorridors - returns corridor values by pair
+func (s *Service) getPaysuperCorridors() (map[string]float64, error) {
+	var items []*paysuperCorridor
+
+	err := s.db.Collection(collectionNamePaysuperCorridors).Find(bson.M{"deleted_at": nil}).All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperCorridors),
+		)
+		return nil, err
+	}
+
+	res := make(map[string]float64, len(items))
+	for _, item := range items {
+		res[item.Pair] = item.Value
+	}
+
+	return res, nil
+}
+
+// getPaysuperCorridor - returns corridor of the pair, or the default corridor if the pair has no own one
+func (s *Service) getPaysuperCorridor(corridors map[string]float64, pair string) float64 {
+	if value, ok := corridors[pair]; ok {
+		return value
+	}
+	return s.getConfig().PaysuperCorridor
+}
+
+// applyPaysuperCorridor - keeps the rate within the corridor around the reference rate,
+// depending on the corridor action the rate outside the corridor is moved to its nearest bound or refused.
+// Zero corridor means there is no corridor for the pair
+func (s *Service) applyPaysuperCorridor(
+	pair string,
+	rate decimal.Decimal,
+	reference decimal.Decimal,
+	corridor float64,
+) (decimal.Decimal, error) {
+	if corridor <= 0 || reference.IsZero() {
+		return rate, nil
+	}
+
+	delta := reference.Mul(decimal.NewFromFloat(corridor))
+	lower := reference.Sub(delta)
+	upper := reference.Add(delta)
+
+	bound := rate
+	if rate.Cmp(lower) < 0 {
+		bound = lower
+	} else if rate.Cmp(upper) > 0 {
+		bound = upper
+	}
+
+	if bound.Cmp(rate) == 0 {
+		return rate, nil
+	}
+
+	zap.S().Warnw(errorCorridorRateOutside, "pair", pair, "rate", rate.Float64(),
+		"reference", reference.Float64(), "corridor", corridor)
+
+	if s.getConfig().PaysuperCorridorAction == corridorActionReject {
+		return rate, errCorridorRateOutside
+	}
+
+	return bound, nil
+}
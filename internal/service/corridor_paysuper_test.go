@@ -0,0 +1,120 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorridorPaysuper_SetPaysuperCorridor_Ok() {
+	req := &currencies.CorrectionCorridor{Pair: "USDRUB", Value: 0.05, UpdatedBy: "admin"}
+	err := suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	req.Value = 0.02
+	req.UpdatedBy = "finance"
+	err = suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	res := &currencies.CorrectionCorridorsResponse{}
+	err = suite.service.GetPaysuperCorridors(context.TODO(), &currencies.EmptyRequest{}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Items[0].Value, 0.02)
+	assert.Equal(suite.T(), res.Items[0].UpdatedBy, "finance")
+
+	corridors, err := suite.service.getPaysuperCorridors()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.service.getPaysuperCorridor(corridors, "USDRUB"), 0.02)
+	assert.Equal(suite.T(), suite.service.getPaysuperCorridor(corridors, "USDEUR"), suite.service.getConfig().PaysuperCorridor)
+
+	// zero corridor turns off the default corridor for the pair
+	req.Value = 0
+	err = suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	delReq := &currencies.CorrectionCorridorRequest{Pair: "USDRUB"}
+	err = suite.service.DeletePaysuperCorridor(context.TODO(), delReq, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	delReq.UserId = "auditor"
+	err = suite.service.DeletePaysuperCorridor(context.TODO(), delReq, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	// the deleted corridor is kept with the user who deleted it, the default corridor is applied to the pair
+	deleted := &paysuperCorridor{}
+	err = suite.service.db.Collection(collectionNamePaysuperCorridors).Find(bson.M{"pair": "USDRUB"}).One(deleted)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), deleted.DeletedBy, "auditor")
+	assert.NotNil(suite.T(), deleted.DeletedAt)
+
+	corridors, err = suite.service.getPaysuperCorridors()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.service.getPaysuperCorridor(corridors, "USDRUB"), suite.service.getConfig().PaysuperCorridor)
+
+	res = &currencies.CorrectionCorridorsResponse{}
+	err = suite.service.GetPaysuperCorridors(context.TODO(), &currencies.EmptyRequest{}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 0)
+
+	err = suite.service.DeletePaysuperCorridor(context.TODO(), delReq, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorridorNotFound)
+
+	// the corridor set again is not deleted
+	req.Value = 0.03
+	err = suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	corridors, err = suite.service.getPaysuperCorridors()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.service.getPaysuperCorridor(corridors, "USDRUB"), 0.03)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorridorPaysuper_SetPaysuperCorridor_Fail() {
+	req := &currencies.CorrectionCorridor{Pair: "USDRUB", Value: 1.5, UpdatedBy: "admin"}
+	err := suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	req = &currencies.CorrectionCorridor{Pair: "USDXXX", Value: 0.05, UpdatedBy: "admin"}
+	err = suite.service.SetPaysuperCorridor(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyPairNotExists)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorridorPaysuper_applyPaysuperCorridor() {
+	reference := decimal.NewFromInt(100)
+
+	rate, err := suite.service.applyPaysuperCorridor("USDRUB", decimal.NewFromInt(104), reference, 0.05)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rate.StringFixed(2), "104.00")
+
+	rate, err = suite.service.applyPaysuperCorridor("USDRUB", decimal.NewFromInt(110), reference, 0.05)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rate.StringFixed(2), "105.00")
+
+	rate, err = suite.service.applyPaysuperCorridor("USDRUB", decimal.NewFromInt(90), reference, 0.05)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rate.StringFixed(2), "95.00")
+
+	// there is no corridor for the pair
+	rate, err = suite.service.applyPaysuperCorridor("USDRUB", decimal.NewFromInt(90), reference, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rate.StringFixed(2), "90.00")
+
+	suite.service.getConfig().PaysuperCorridorAction = corridorActionReject
+	_, err = suite.service.applyPaysuperCorridor("USDRUB", decimal.NewFromInt(110), reference, 0.05)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err, errCorridorRateOutside)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCorridorPaysuper_getRatePaysuper() {
+	params := &paysuperForecastParams{Pair: "USDRUB", WindowDays: 30, VolatilityFactor: 3}
+
+	// r+1 is clamped to the upper bound of 1% corridor around r
+	rd, err := suite.service.getRatePaysuper("USD", "RUB", params, 0, 0.01)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.RateDecimal, "65.277714")
+}
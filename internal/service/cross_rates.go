@@ -0,0 +1,102 @@
+package service
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	crossSource = "CROSS"
+)
+
+// getCrossRate - calculates rate for the pair that has no direct quote,
+// by the rates of both currencies to the pivot currency, like KZTBRL = KZTUSD * USDBRL
+func (s *Service) getCrossRate(rateType, from, to string, query bson.M, source string, res *currencies.RateData) error {
+	for _, pivot := range s.getCrossRatesPivots(rateType, source) {
+		if pivot == from || pivot == to || !s.isCurrencySupported(pivot) {
+			continue
+		}
+
+		leg1 := &currencies.RateData{}
+		err := s.getDirectRate(rateType, from+pivot, s.copyQuery(query), source, leg1)
+		if err == mgo.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		leg2 := &currencies.RateData{}
+		err = s.getDirectRate(rateType, pivot+to, s.copyQuery(query), source, leg2)
+		if err == mgo.ErrNotFound {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		s.fillCrossRate(from+to, pivot, leg1, leg2, res)
+		s.setRateProvenance(res, rateType, leg1.Provenance.GetFallback() || leg2.Provenance.GetFallback())
+
+		zap.S().Infow("cross rate calculated", "pair", res.Pair, "pivot", pivot, "rateType", rateType, "source", source)
+
+		return nil
+	}
+
+	return mgo.ErrNotFound
+}
+
+// getCrossRatesPivots - returns pivot currencies in order of priority,
+// the home currency of the central bank goes first, if it's requested
+func (s *Service) getCrossRatesPivots(rateType, source string) []string {
+	var pivots []string
+
+	if rateType == currencies.RateTypeCentralbanks {
+		if src, ok := GetRateSource(source); ok && src.BaseCurrency() != "" {
+			pivots = append(pivots, src.BaseCurrency())
+		}
+	}
+
+	return append(pivots, s.getConfig().CrossRatesPivots...)
+}
+
+func (s *Service) fillCrossRate(pair, pivot string, leg1, leg2 *currencies.RateData, res *currencies.RateData) {
+	res.Id = ""
+	res.Pair = pair
+	s.setRateDecimal(res, s.getRateDecimal(leg1).Mul(s.getRateDecimal(leg2)))
+	res.Volume = 1
+
+	res.Source = crossSource
+	if leg1.Source == leg2.Source {
+		res.Source = leg1.Source
+	}
+
+	// cross rate is actual as of its oldest leg
+	oldest := leg1
+	if t1, err1 := ptypes.Timestamp(leg1.EffectiveDate); err1 == nil {
+		if t2, err2 := ptypes.Timestamp(leg2.EffectiveDate); err2 == nil && t2.Before(t1) {
+			oldest = leg2
+		}
+	}
+	res.CreatedAt = oldest.CreatedAt
+	res.EffectiveDate = oldest.EffectiveDate
+
+	res.CrossRate = &currencies.CrossRate{
+		Pivot: pivot,
+		Legs:  []*currencies.RateLeg{s.getRateLeg(leg1), s.getRateLeg(leg2)},
+	}
+}
+
+func (s *Service) getRateLeg(rd *currencies.RateData) *currencies.RateLeg {
+	return &currencies.RateLeg{
+		Id:            rd.Id,
+		Pair:          rd.Pair,
+		Rate:          rd.Rate,
+		Source:        rd.Source,
+		CreatedAt:     rd.CreatedAt,
+		EffectiveDate: rd.EffectiveDate,
+	}
+}
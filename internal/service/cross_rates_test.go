@@ -0,0 +1,61 @@
+package service
+
+import (
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCrossRates_getRate_Ok() {
+	rates := []interface{}{
+		&currencies.RateData{Pair: "KZTUSD", Rate: 0.0024, Source: oxrSource, Volume: 1},
+		&currencies.RateData{Pair: "USDBRL", Rate: 5.2, Source: oxrSource, Volume: 1},
+	}
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, rates)
+	assert.NoError(suite.T(), err)
+
+	res := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeOxr, "KZT", "BRL", bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Pair, "KZTBRL")
+	assert.Equal(suite.T(), res.Rate, suite.service.toPrecise(0.0024*5.2))
+	assert.Equal(suite.T(), res.Source, oxrSource)
+	assert.NotNil(suite.T(), res.CrossRate)
+	assert.Equal(suite.T(), res.CrossRate.Pivot, "USD")
+	assert.Len(suite.T(), res.CrossRate.Legs, 2)
+	assert.Equal(suite.T(), res.CrossRate.Legs[0].Pair, "KZTUSD")
+	assert.Equal(suite.T(), res.CrossRate.Legs[1].Pair, "USDBRL")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCrossRates_getRate_CentralbankPivot() {
+	rates := []interface{}{
+		&currencies.RateData{Pair: "KZTRUB", Rate: 0.17, Source: cbrfSource, Volume: 1},
+		&currencies.RateData{Pair: "RUBBRL", Rate: 0.07, Source: cbrfSource, Volume: 1},
+	}
+	err := suite.service.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	assert.NoError(suite.T(), err)
+
+	res := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeCentralbanks, "KZT", "BRL", bson.M{}, cbrfSource, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Pair, "KZTBRL")
+	assert.Equal(suite.T(), res.Rate, float64(0.0119))
+	assert.Equal(suite.T(), res.Source, cbrfSource)
+	assert.Equal(suite.T(), res.CrossRate.Pivot, cbrfTo)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCrossRates_getRate_NotFound() {
+	res := &currencies.RateData{}
+	err := suite.service.getRate(currencies.RateTypeOxr, "KZT", "BRL", bson.M{}, "", res)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err, mgo.ErrNotFound)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCrossRates_getCrossRatesPivots() {
+	pivots := suite.service.getCrossRatesPivots(currencies.RateTypeCentralbanks, cbeuSource)
+	assert.Equal(suite.T(), pivots, []string{cbeuTo, "USD", "EUR"})
+
+	pivots = suite.service.getCrossRatesPivots(currencies.RateTypeOxr, "")
+	assert.Equal(suite.T(), pivots, []string{"USD", "EUR"})
+}
@@ -0,0 +1,462 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/internal/currency"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"sort"
+	"time"
+)
+
+const (
+	collectionNameCurrencies        = "currencies"
+	collectionNameCurrenciesHistory = "currencies_history"
+
+	currencyActionCreate = "create"
+	currencyActionUpdate = "update"
+	currencyActionDelete = "delete"
+
+	errorCurrenciesLoadFailed      = "currencies load failed"
+	errorCurrencyNotFound          = "currency not found"
+	errorCurrencyMinorUnits        = "currency minor units less than precision"
+	errorCurrencyVersionConflict   = "currency has been changed by another request"
+	errorCurrencyUpdateFailed      = "currency update failed"
+	errorCurrencyHistorySaveFailed = "currency history save failed"
+	errorCurrencyHistoryNotFound   = "currency history not found"
+	errorCurrencyLastDelete        = "the last currency can't be deleted"
+)
+
+// currencyDefinition - currency properties stored in the currencies collection
+type currencyDefinition struct {
+	Code       string    `bson:"_id"`
+	Settlement bool      `bson:"settlement"`
+	Price      bool      `bson:"price"`
+	Vat        bool      `bson:"vat"`
+	Local      bool      `bson:"local"`
+	Accounting bool      `bson:"accounting"`
+	Precision  int32     `bson:"precision"`
+	MinorUnits int32     `bson:"minor_units"`
+	Version    int64     `bson:"version"`
+	UpdatedAt  time.Time `bson:"updated_at"`
+	UpdatedBy  string    `bson:"updated_by"`
+}
+
+// currencyHistoryItem - audit record of a currency change, it contains the definition before and after the change
+type currencyHistoryItem struct {
+	Id        bson.ObjectId       `bson:"_id"`
+	Code      string              `bson:"code"`
+	Action    string              `bson:"action"`
+	Version   int64               `bson:"version"`
+	Before    *currencyDefinition `bson:"before"`
+	After     *currencyDefinition `bson:"after"`
+	CreatedAt time.Time           `bson:"created_at"`
+	CreatedBy string              `bson:"created_by"`
+}
+
+// GetCurrencies - returns definitions of all supported currencies
+func (s *Service) GetCurrencies(
+	ctx context.Context,
+	req *currencies.EmptyRequest,
+	res *currencies.CurrenciesDefinitionsResponse,
+) error {
+	var items []*currencyDefinition
+	err := s.db.Collection(collectionNameCurrencies).Find(nil).Sort("_id").All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+		)
+		return err
+	}
+
+	// currencies are not managed yet, the built-in definitions are used
+	if len(items) == 0 {
+		for code, properties := range s.getConfig().Currencies {
+			items = append(items, s.newCurrencyDefinition(code, properties))
+		}
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].Code < items[j].Code
+		})
+	}
+
+	for _, item := range items {
+		res.Currencies = append(res.Currencies, s.toCurrencyDefinitionProto(item))
+	}
+
+	return nil
+}
+
+// SetCurrency - creates new currency or updates existing one, the change is applied without restart
+func (s *Service) SetCurrency(
+	ctx context.Context,
+	req *currencies.CurrencyDefinition,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	if req.MinorUnits < req.Precision {
+		zap.S().Errorw(errorCurrencyMinorUnits, "req", req)
+		return errors.New(errorCurrencyMinorUnits)
+	}
+
+	if err := s.initCurrencies(); err != nil {
+		return err
+	}
+
+	before, err := s.getCurrencyDefinition(req.Code)
+	if err != nil && err != mgo.ErrNotFound {
+		return err
+	}
+
+	after := &currencyDefinition{
+		Code:       req.Code,
+		Settlement: req.Settlement,
+		Price:      req.Price,
+		Vat:        req.Vat,
+		Local:      req.Local,
+		Accounting: req.Accounting,
+		Precision:  req.Precision,
+		MinorUnits: req.MinorUnits,
+		Version:    1,
+		UpdatedAt:  time.Now(),
+		UpdatedBy:  req.UpdatedBy,
+	}
+
+	action := currencyActionCreate
+	if before != nil {
+		action = currencyActionUpdate
+		after.Version = before.Version + 1
+
+		// the version is passed to prevent overwriting of concurrent changes
+		if req.Version > 0 && req.Version != before.Version {
+			zap.S().Errorw(errorCurrencyVersionConflict, "req", req, "version", before.Version)
+			return errors.New(errorCurrencyVersionConflict)
+		}
+
+		err = s.db.Collection(collectionNameCurrencies).Update(bson.M{"_id": req.Code, "version": before.Version}, after)
+	} else {
+		err = s.db.Collection(collectionNameCurrencies).Insert(after)
+	}
+
+	if err == mgo.ErrNotFound || mgo.IsDup(err) {
+		zap.S().Errorw(errorCurrencyVersionConflict, "req", req)
+		return errors.New(errorCurrencyVersionConflict)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			errorCurrencyUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+			zap.Any(pkg.ErrorDatabaseFieldSet, after),
+		)
+		return err
+	}
+
+	return s.onCurrencyChanged(action, before, after, req.UpdatedBy)
+}
+
+// DeleteCurrency - removes currency from supported currencies, the change is applied without restart
+func (s *Service) DeleteCurrency(
+	ctx context.Context,
+	req *currencies.DeleteCurrencyRequest,
+	res *currencies.EmptyResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	if err := s.initCurrencies(); err != nil {
+		return err
+	}
+
+	before, err := s.getCurrencyDefinition(req.Code)
+	if err == mgo.ErrNotFound {
+		zap.S().Errorw(errorCurrencyNotFound, "req", req)
+		return errors.New(errorCurrencyNotFound)
+	}
+	if err != nil {
+		return err
+	}
+
+	// empty collection means the currencies are not managed and the built-in definitions are used,
+	// so the last currency is never deleted
+	n, err := s.db.Collection(collectionNameCurrencies).Count()
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+		)
+		return err
+	}
+	if n <= 1 {
+		zap.S().Errorw(errorCurrencyLastDelete, "req", req)
+		return errors.New(errorCurrencyLastDelete)
+	}
+
+	query := bson.M{"_id": req.Code, "version": before.Version}
+	err = s.db.Collection(collectionNameCurrencies).Remove(query)
+
+	if err == mgo.ErrNotFound {
+		zap.S().Errorw(errorCurrencyVersionConflict, "req", req)
+		return errors.New(errorCurrencyVersionConflict)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			errorCurrencyUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	return s.onCurrencyChanged(currencyActionDelete, before, nil, req.UpdatedBy)
+}
+
+// GetCurrencyHistory - returns changes of the currency, the latest first
+func (s *Service) GetCurrencyHistory(
+	ctx context.Context,
+	req *currencies.CurrencyRequest,
+	res *currencies.CurrencyHistoryResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	var items []*currencyHistoryItem
+	query := bson.M{"code": req.Code}
+
+	err := s.db.Collection(collectionNameCurrenciesHistory).Find(query).Sort("-created_at", "-_id").All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrenciesHistory),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	if len(items) == 0 {
+		return errors.New(errorCurrencyHistoryNotFound)
+	}
+
+	for _, item := range items {
+		createdAt, _ := ptypes.TimestampProto(item.CreatedAt)
+		res.Items = append(res.Items, &currencies.CurrencyHistoryItem{
+			Id:        item.Id.Hex(),
+			Code:      item.Code,
+			Action:    item.Action,
+			Version:   item.Version,
+			Before:    s.toCurrencyDefinitionProto(item.Before),
+			After:     s.toCurrencyDefinitionProto(item.After),
+			CreatedAt: createdAt,
+			CreatedBy: item.CreatedBy,
+		})
+	}
+
+	return nil
+}
+
+// initCurrencies - fills empty currencies collection with the built-in definitions before the first change,
+// so the currencies that are not changed are kept
+func (s *Service) initCurrencies() error {
+	n, err := s.db.Collection(collectionNameCurrencies).Count()
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+		)
+		return err
+	}
+
+	if n > 0 {
+		return nil
+	}
+
+	var items []interface{}
+	for code, properties := range s.getConfig().Currencies {
+		item := s.newCurrencyDefinition(code, properties)
+		item.Version = 1
+		item.UpdatedAt = time.Now()
+		items = append(items, item)
+	}
+
+	err = s.db.Collection(collectionNameCurrencies).Insert(items...)
+	if err != nil && !mgo.IsDup(err) {
+		zap.S().Errorw(errorDbInsertFailed, "error", err, "collection", collectionNameCurrencies)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) getCurrencyDefinition(code string) (*currencyDefinition, error) {
+	res := &currencyDefinition{}
+	err := s.db.Collection(collectionNameCurrencies).FindId(code).One(res)
+
+	if err != nil && err != mgo.ErrNotFound {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+			zap.String("code", code),
+		)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *Service) onCurrencyChanged(action string, before, after *currencyDefinition, userId string) error {
+	item := &currencyHistoryItem{
+		Id:        bson.NewObjectId(),
+		Action:    action,
+		Before:    before,
+		After:     after,
+		CreatedAt: time.Now(),
+		CreatedBy: userId,
+	}
+
+	if after != nil {
+		item.Code = after.Code
+		item.Version = after.Version
+	} else {
+		item.Code = before.Code
+		item.Version = before.Version
+	}
+
+	err := s.db.Collection(collectionNameCurrenciesHistory).Insert(item)
+	if err != nil {
+		zap.S().Errorw(errorCurrencyHistorySaveFailed, "error", err, "item", item)
+		s.sendCentrifugoMessage(errorCurrencyHistorySaveFailed, err)
+	}
+
+	zap.S().Infow("Currency changed", "code", item.Code, "action", action, "version", item.Version, "user", userId)
+
+	return s.loadCurrencies()
+}
+
+// loadCurrencies - loads currencies definitions from db and rebuilds the currencies lists of config,
+// the built-in definitions are kept if there are no currencies in db
+func (s *Service) loadCurrencies() error {
+	// concurrent reloads are serialized, so the config of the older load doesn't replace the newer one
+	s.currenciesMx.Lock()
+	defer s.currenciesMx.Unlock()
+
+	var items []*currencyDefinition
+
+	err := s.db.Collection(collectionNameCurrencies).Find(nil).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorCurrenciesLoadFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameCurrencies),
+		)
+		return err
+	}
+
+	if len(items) == 0 {
+		return nil
+	}
+
+	definitions := make(map[string]currency.CurrencyProperties, len(items))
+	for _, item := range items {
+		definitions[item.Code] = currency.CurrencyProperties{
+			Settlement: item.Settlement,
+			Price:      item.Price,
+			Vat:        item.Vat,
+			Local:      item.Local,
+			Accounting: item.Accounting,
+			Precision:  item.Precision,
+			MinorUnits: item.MinorUnits,
+		}
+	}
+
+	// config is replaced as a whole, so the concurrent requests see consistent currencies lists
+	cfg := *s.getConfig()
+	cfg.SetCurrencies(definitions)
+	s.cfg.Store(&cfg)
+
+	return nil
+}
+
+func (s *Service) startCurrenciesReload() {
+	if s.getConfig().CurrenciesReloadInterval <= 0 {
+		return
+	}
+
+	s.currenciesReloadStop = make(chan bool)
+
+	go func(stop <-chan bool) {
+		ticker := time.NewTicker(time.Duration(s.getConfig().CurrenciesReloadInterval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				// changes made by other replicas of the service
+				_ = s.loadCurrencies()
+			case <-stop:
+				return
+			}
+		}
+	}(s.currenciesReloadStop)
+}
+
+func (s *Service) newCurrencyDefinition(code string, properties currency.CurrencyProperties) *currencyDefinition {
+	return &currencyDefinition{
+		Code:       code,
+		Settlement: properties.Settlement,
+		Price:      properties.Price,
+		Vat:        properties.Vat,
+		Local:      properties.Local,
+		Accounting: properties.Accounting,
+		Precision:  properties.Precision,
+		MinorUnits: properties.MinorUnits,
+	}
+}
+
+func (s *Service) toCurrencyDefinitionProto(item *currencyDefinition) *currencies.CurrencyDefinition {
+	if item == nil {
+		return nil
+	}
+
+	res := &currencies.CurrencyDefinition{
+		Code:       item.Code,
+		Settlement: item.Settlement,
+		Price:      item.Price,
+		Vat:        item.Vat,
+		Local:      item.Local,
+		Accounting: item.Accounting,
+		Precision:  item.Precision,
+		MinorUnits: item.MinorUnits,
+		Version:    item.Version,
+		UpdatedBy:  item.UpdatedBy,
+	}
+
+	if !item.UpdatedAt.IsZero() {
+		res.UpdatedAt, _ = ptypes.TimestampProto(item.UpdatedAt)
+	}
+
+	return res
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_GetCurrencies_BuiltIn() {
+	res := &currencies.CurrenciesDefinitionsResponse{}
+	err := suite.service.GetCurrencies(context.TODO(), &currencies.EmptyRequest{}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Currencies, len(suite.config.Currencies))
+	assert.Equal(suite.T(), res.Currencies[0].Code, "AED")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_SetCurrency_Ok() {
+	assert.False(suite.T(), suite.service.isCurrencySupported("MDL"))
+
+	req := &currencies.CurrencyDefinition{
+		Code:       "MDL",
+		Price:      true,
+		Vat:        true,
+		Precision:  2,
+		MinorUnits: 2,
+		UpdatedBy:  "admin",
+	}
+	err := suite.service.SetCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	// the change is applied without restart, and other currencies are kept
+	assert.True(suite.T(), suite.service.isCurrencySupported("MDL"))
+	assert.True(suite.T(), suite.service.getConfig().RatesRequestCurrenciesParsed["MDL"])
+	assert.True(suite.T(), suite.service.isCurrencySupported("USD"))
+	assert.Len(suite.T(), suite.service.getConfig().SupportedCurrencies, len(suite.config.Currencies)+1)
+
+	// update with the outdated version is rejected
+	req.Settlement = true
+	req.Version = 5
+	err = suite.service.SetCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyVersionConflict)
+
+	req.Version = 1
+	err = suite.service.SetCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), suite.service.getConfig().SettlementCurrenciesParsed["MDL"])
+	assert.True(suite.T(), suite.service.getConfig().OxrRatesDirectPairs["MDLUSD"])
+
+	history := &currencies.CurrencyHistoryResponse{}
+	err = suite.service.GetCurrencyHistory(context.TODO(), &currencies.CurrencyRequest{Code: "MDL"}, history)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), history.Items, 2)
+	assert.Equal(suite.T(), history.Items[0].Action, currencyActionUpdate)
+	assert.EqualValues(suite.T(), history.Items[0].Version, 2)
+	assert.False(suite.T(), history.Items[0].Before.Settlement)
+	assert.True(suite.T(), history.Items[0].After.Settlement)
+	assert.Equal(suite.T(), history.Items[1].Action, currencyActionCreate)
+	assert.Nil(suite.T(), history.Items[1].Before)
+	assert.Equal(suite.T(), history.Items[1].CreatedBy, "admin")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_SetCurrency_Fail() {
+	req := &currencies.CurrencyDefinition{
+		Code:       "bla",
+		Precision:  2,
+		MinorUnits: 2,
+		UpdatedBy:  "admin",
+	}
+	err := suite.service.SetCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	req.Code = "BHD"
+	req.Precision = 3
+	err = suite.service.SetCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyMinorUnits)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_DeleteCurrency() {
+	assert.True(suite.T(), suite.service.isCurrencySupported("UAH"))
+
+	req := &currencies.DeleteCurrencyRequest{Code: "UAH", UpdatedBy: "admin"}
+	err := suite.service.DeleteCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), suite.service.isCurrencySupported("UAH"))
+	assert.True(suite.T(), suite.service.isCurrencySupported("USD"))
+
+	err = suite.service.DeleteCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyNotFound)
+
+	history := &currencies.CurrencyHistoryResponse{}
+	err = suite.service.GetCurrencyHistory(context.TODO(), &currencies.CurrencyRequest{Code: "UAH"}, history)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), history.Items, 1)
+	assert.Equal(suite.T(), history.Items[0].Action, currencyActionDelete)
+	assert.Equal(suite.T(), history.Items[0].CreatedBy, "admin")
+	assert.Nil(suite.T(), history.Items[0].After)
+
+	req.UpdatedBy = ""
+	err = suite.service.DeleteCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_DeleteCurrency_Last() {
+	item := suite.service.newCurrencyDefinition("USD", suite.config.Currencies["USD"])
+	err := suite.service.db.Collection(collectionNameCurrencies).Insert(item)
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.DeleteCurrencyRequest{Code: "USD", UpdatedBy: "admin"}
+	err = suite.service.DeleteCurrency(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCurrencyLastDelete)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestCurrencyRegistry_loadCurrencies() {
+	err := suite.service.loadCurrencies()
+	assert.NoError(suite.T(), err)
+	// built-in definitions are kept while currencies are not managed
+	assert.Len(suite.T(), suite.service.getConfig().SupportedCurrencies, len(suite.config.Currencies))
+
+	item := suite.service.newCurrencyDefinition("MDL", suite.config.Currencies["UAH"])
+	err = suite.service.db.Collection(collectionNameCurrencies).Insert(item)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.loadCurrencies()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), suite.service.getConfig().SupportedCurrencies, []string{"MDL"})
+}
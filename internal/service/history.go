@@ -0,0 +1,216 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/jinzhu/now"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+const (
+	historyAggregationDaily   = "daily"
+	historyAggregationWeekly  = "weekly"
+	historyAggregationMonthly = "monthly"
+
+	errorHistoryPeriodInvalid      = "rate history period invalid"
+	errorHistoryItemsLimit         = "rate history items limit exceeded, request aggregated history or shorter period"
+	errorHistoryRequestFailed      = "rate history request failed"
+	errorHistoryAggregationInvalid = "rate history aggregation invalid"
+	errorHistorySourceRequired     = "rate history source required for central banks rates"
+	errorHistoryDirectionRequired  = "rate history exchange direction required for stock rates"
+)
+
+// historyPeriod - the rates of one period aggregated by the pipeline of getRateHistoryPeriods
+type historyPeriod struct {
+	PeriodStart time.Time `bson:"period_start"`
+	Open        float64   `bson:"open"`
+	Close       float64   `bson:"close"`
+	Min         float64   `bson:"min"`
+	Max         float64   `bson:"max"`
+	Average     float64   `bson:"average"`
+	Count       int32     `bson:"count"`
+}
+
+// GetRateHistory - returns the direct rates of the pair between two dates by effective date,
+// or open/close/min/max/average of the rates per day, ISO week or month if the aggregation is requested
+func (s *Service) GetRateHistory(
+	ctx context.Context,
+	req *currencies.RateHistoryRequest,
+	res *currencies.RateHistoryResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	dateFrom, err := ptypes.Timestamp(req.DateFrom)
+	if err != nil {
+		zap.S().Errorw(errorDatetimeConversion, "error", err, "req", req)
+		return err
+	}
+
+	dateTo, err := ptypes.Timestamp(req.DateTo)
+	if err != nil {
+		zap.S().Errorw(errorDatetimeConversion, "error", err, "req", req)
+		return err
+	}
+
+	if dateTo.Before(dateFrom) {
+		zap.S().Errorw(errorHistoryPeriodInvalid, "req", req)
+		return errors.New(errorHistoryPeriodInvalid)
+	}
+
+	// the rates of different central banks and of both stock sides aren't comparable in one series
+	if req.RateType == currencies.RateTypeCentralbanks && req.Source == "" {
+		zap.S().Errorw(errorHistorySourceRequired, "req", req)
+		return errors.New(errorHistorySourceRequired)
+	}
+
+	if req.RateType == currencies.RateTypeStock && req.ExchangeDirection == "" {
+		zap.S().Errorw(errorHistoryDirectionRequired, "req", req)
+		return errors.New(errorHistoryDirectionRequired)
+	}
+
+	cName, err := s.getCollectionName(req.RateType)
+	if err != nil {
+		return err
+	}
+
+	query := bson.M{
+		"pair": strings.ToUpper(req.From + req.To),
+		"effective_date": bson.M{
+			"$gte": now.New(dateFrom).BeginningOfDay(),
+			"$lte": now.New(dateTo).EndOfDay(),
+		},
+	}
+	if req.Source != "" {
+		query["source"] = strings.ToUpper(req.Source)
+	}
+	query = s.getSideQuery(req.RateType, req.ExchangeDirection, query)
+
+	if req.Aggregation != "" {
+		res.Periods, err = s.getRateHistoryPeriods(cName, query, req.Aggregation)
+		return err
+	}
+
+	// one more item is requested to detect the exceeding of the limit
+	var items []*currencies.RateData
+	err = s.db.Collection(cName).Find(query).Sort("effective_date", "_id").Limit(s.getConfig().HistoryMaxItems + 1).All(&items)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	if len(items) > s.getConfig().HistoryMaxItems {
+		zap.S().Errorw(errorHistoryItemsLimit, "req", req, "limit", s.getConfig().HistoryMaxItems)
+		return errors.New(errorHistoryItemsLimit)
+	}
+
+	for _, item := range items {
+		s.setRateProvenance(item, req.RateType, false)
+	}
+	res.Rates = items
+
+	return nil
+}
+
+// getRateHistoryPeriods - aggregates the rates matched the query per period in UTC, the periods without rates are absent
+func (s *Service) getRateHistoryPeriods(cName string, query bson.M, aggregation string) ([]*currencies.RateHistoryPeriod, error) {
+	var group, start bson.M
+
+	switch aggregation {
+	case historyAggregationDaily:
+		group = bson.M{
+			"year":  bson.M{"$year": "$effective_date"},
+			"month": bson.M{"$month": "$effective_date"},
+			"day":   bson.M{"$dayOfMonth": "$effective_date"},
+		}
+		start = bson.M{"year": "$_id.year", "month": "$_id.month", "day": "$_id.day"}
+	case historyAggregationWeekly:
+		group = bson.M{
+			"year": bson.M{"$isoWeekYear": "$effective_date"},
+			"week": bson.M{"$isoWeek": "$effective_date"},
+		}
+		start = bson.M{"isoWeekYear": "$_id.year", "isoWeek": "$_id.week"}
+	case historyAggregationMonthly:
+		group = bson.M{
+			"year":  bson.M{"$year": "$effective_date"},
+			"month": bson.M{"$month": "$effective_date"},
+		}
+		start = bson.M{"year": "$_id.year", "month": "$_id.month"}
+	default:
+		return nil, errors.New(errorHistoryAggregationInvalid)
+	}
+
+	pipeline := []bson.M{
+		{"$match": query},
+		{"$sort": bson.D{{Name: "effective_date", Value: 1}, {Name: "_id", Value: 1}}},
+		{
+			"$group": bson.M{
+				"_id":     group,
+				"open":    bson.M{"$first": "$rate"},
+				"close":   bson.M{"$last": "$rate"},
+				"min":     bson.M{"$min": "$rate"},
+				"max":     bson.M{"$max": "$rate"},
+				"average": bson.M{"$avg": "$rate"},
+				"count":   bson.M{"$sum": 1},
+			},
+		},
+		{
+			"$project": bson.M{
+				"_id":          0,
+				"period_start": bson.M{"$dateFromParts": start},
+				"open":         1,
+				"close":        1,
+				"min":          1,
+				"max":          1,
+				"average":      1,
+				"count":        1,
+			},
+		},
+		{"$sort": bson.M{"period_start": 1}},
+	}
+
+	var items []*historyPeriod
+	err := s.db.Collection(cName).Pipe(pipeline).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorHistoryRequestFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return nil, err
+	}
+
+	res := make([]*currencies.RateHistoryPeriod, 0, len(items))
+	for _, item := range items {
+		periodStart, err := ptypes.TimestampProto(item.PeriodStart)
+		if err != nil {
+			return nil, err
+		}
+
+		res = append(res, &currencies.RateHistoryPeriod{
+			PeriodStart: periodStart,
+			Open:        item.Open,
+			Close:       item.Close,
+			Min:         item.Min,
+			Max:         item.Max,
+			Average:     s.toPrecise(item.Average),
+			Count:       item.Count,
+		})
+	}
+
+	return res, nil
+}
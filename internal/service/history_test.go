@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) saveHistoryRates() {
+	var rates []interface{}
+	for _, item := range []struct {
+		date time.Time
+		rate float64
+	}{
+		{time.Date(2020, 1, 6, 10, 0, 0, 0, time.UTC), 1},
+		{time.Date(2020, 1, 6, 12, 0, 0, 0, time.UTC), 3},
+		{time.Date(2020, 1, 8, 10, 0, 0, 0, time.UTC), 2},
+		{time.Date(2020, 2, 3, 10, 0, 0, 0, time.UTC), 4},
+	} {
+		effectiveDate, err := ptypes.TimestampProto(item.date)
+		assert.NoError(suite.T(), err)
+		rates = append(rates, &currencies.RateData{
+			Pair:          "EURGBP",
+			Rate:          item.rate,
+			Source:        "TEST",
+			Volume:        1,
+			CreatedAt:     effectiveDate,
+			EffectiveDate: effectiveDate,
+		})
+	}
+
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, rates)
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) getHistoryRequest(aggregation string, from, to time.Time) *currencies.RateHistoryRequest {
+	dateFrom, err := ptypes.TimestampProto(from)
+	assert.NoError(suite.T(), err)
+	dateTo, err := ptypes.TimestampProto(to)
+	assert.NoError(suite.T(), err)
+
+	return &currencies.RateHistoryRequest{
+		From:        "EUR",
+		To:          "GBP",
+		RateType:    currencies.RateTypeOxr,
+		DateFrom:    dateFrom,
+		DateTo:      dateTo,
+		Aggregation: aggregation,
+	}
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestHistory_GetRateHistory_Ok() {
+	suite.saveHistoryRates()
+
+	req := suite.getHistoryRequest("", time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC), time.Date(2020, 1, 31, 0, 0, 0, 0, time.UTC))
+	res := &currencies.RateHistoryResponse{}
+	err := suite.service.GetRateHistory(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), res.Periods)
+	assert.Len(suite.T(), res.Rates, 3)
+	assert.Equal(suite.T(), res.Rates[0].Rate, float64(1))
+	assert.Equal(suite.T(), res.Rates[1].Rate, float64(3))
+	assert.Equal(suite.T(), res.Rates[2].Rate, float64(2))
+	assert.Equal(suite.T(), res.Rates[0].Provenance.RateType, currencies.RateTypeOxr)
+
+	// the last day is included
+	req = suite.getHistoryRequest("", time.Date(2020, 1, 7, 0, 0, 0, 0, time.UTC), time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC))
+	res = &currencies.RateHistoryResponse{}
+	err = suite.service.GetRateHistory(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Rates, 2)
+	assert.Equal(suite.T(), res.Rates[1].Rate, float64(4))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestHistory_GetRateHistory_Aggregation() {
+	suite.saveHistoryRates()
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	res := &currencies.RateHistoryResponse{}
+	err := suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest(historyAggregationDaily, from, to), res)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), res.Rates)
+	assert.Len(suite.T(), res.Periods, 3)
+
+	period := res.Periods[0]
+	periodStart, err := ptypes.Timestamp(period.PeriodStart)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), periodStart, time.Date(2020, 1, 6, 0, 0, 0, 0, time.UTC))
+	assert.Equal(suite.T(), period.Open, float64(1))
+	assert.Equal(suite.T(), period.Close, float64(3))
+	assert.Equal(suite.T(), period.Min, float64(1))
+	assert.Equal(suite.T(), period.Max, float64(3))
+	assert.Equal(suite.T(), period.Average, float64(2))
+	assert.Equal(suite.T(), period.Count, int32(2))
+
+	res = &currencies.RateHistoryResponse{}
+	err = suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest(historyAggregationWeekly, from, to), res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Periods, 2)
+	periodStart, err = ptypes.Timestamp(res.Periods[1].PeriodStart)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), periodStart, time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC))
+	assert.Equal(suite.T(), res.Periods[0].Close, float64(2))
+	assert.Equal(suite.T(), res.Periods[0].Count, int32(3))
+
+	res = &currencies.RateHistoryResponse{}
+	err = suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest(historyAggregationMonthly, from, to), res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Periods, 2)
+	periodStart, err = ptypes.Timestamp(res.Periods[0].PeriodStart)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), periodStart, time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.Equal(suite.T(), res.Periods[0].Average, float64(2))
+	assert.Equal(suite.T(), res.Periods[1].Open, float64(4))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestHistory_GetRateHistory_Fail() {
+	suite.saveHistoryRates()
+
+	from := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 2, 29, 0, 0, 0, 0, time.UTC)
+
+	res := &currencies.RateHistoryResponse{}
+	err := suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest("", to, from), res)
+	assert.EqualError(suite.T(), err, errorHistoryPeriodInvalid)
+
+	err = suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest("hourly", from, to), res)
+	assert.Error(suite.T(), err)
+
+	suite.service.getConfig().HistoryMaxItems = 3
+	err = suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest("", from, to), res)
+	assert.EqualError(suite.T(), err, errorHistoryItemsLimit)
+
+	// the aggregated history is not limited
+	err = suite.service.GetRateHistory(context.TODO(), suite.getHistoryRequest(historyAggregationDaily, from, to), res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Periods, 3)
+
+	req := suite.getHistoryRequest("", from, to)
+	req.RateType = currencies.RateTypeCentralbanks
+	err = suite.service.GetRateHistory(context.TODO(), req, res)
+	assert.EqualError(suite.T(), err, errorHistorySourceRequired)
+
+	req.RateType = currencies.RateTypeStock
+	err = suite.service.GetRateHistory(context.TODO(), req, res)
+	assert.EqualError(suite.T(), err, errorHistoryDirectionRequired)
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+)
+
+const (
+	correctionRuleScopeMerchant = "merchant"
+	correctionRuleScopeCommon   = "common"
+)
+
+// setRateProvenance - fills the provenance of the rate by the rate document,
+// rateType is the type of the collection the document is found in
+func (s *Service) setRateProvenance(rd *currencies.RateData, rateType string, fallback bool) {
+	rd.Provenance = &currencies.RateProvenance{
+		RateId:        rd.Id,
+		RateType:      rateType,
+		Source:        rd.Source,
+		EffectiveDate: rd.EffectiveDate,
+		Fallback:      fallback,
+	}
+}
+
+// setCorrectionProvenance - adds the applied correction rule to the provenance of the rate,
+// the rule without id is an empty rule used when there is no rule for the request
+func (s *Service) setCorrectionProvenance(rd *currencies.RateData, rule *currencies.CorrectionRule) {
+	if rd.Provenance == nil || rule.Id == "" {
+		return
+	}
+
+	rd.Provenance.CorrectionRuleId = rule.Id
+	rd.Provenance.CorrectionRuleScope = correctionRuleScopeCommon
+	if rule.MerchantId != "" {
+		rd.Provenance.CorrectionRuleScope = correctionRuleScopeMerchant
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestProvenance_ExchangeCurrency() {
+	merchantId := bson.NewObjectId().Hex()
+
+	req := &currencies.ExchangeCurrencyCurrentForMerchantRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeOxr,
+		Amount:            100,
+		MerchantId:        merchantId,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+	}
+	res := &currencies.ExchangeCurrencyResponse{}
+	err := suite.service.ExchangeCurrencyCurrentForMerchant(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.NotNil(suite.T(), res.Provenance)
+	assert.NotEmpty(suite.T(), res.Provenance.RateId)
+	assert.NotNil(suite.T(), res.Provenance.EffectiveDate)
+	assert.Equal(suite.T(), res.Provenance.RateType, currencies.RateTypeOxr)
+	assert.Equal(suite.T(), res.Provenance.Source, "TEST")
+	assert.False(suite.T(), res.Provenance.Fallback)
+	assert.Empty(suite.T(), res.Provenance.CorrectionRuleId)
+	assert.Empty(suite.T(), res.Provenance.CorrectionRuleScope)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  1,
+	})
+	assert.NoError(suite.T(), err)
+
+	res = &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentForMerchant(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), res.Provenance.CorrectionRuleId)
+	assert.Equal(suite.T(), res.Provenance.CorrectionRuleScope, correctionRuleScopeCommon)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionBuy,
+		CommonCorrection:  2,
+		MerchantId:        merchantId,
+	})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
+	assert.NoError(suite.T(), err)
+
+	res = &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.ExchangeCurrencyCurrentForMerchant(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Provenance.CorrectionRuleId, rule.Id)
+	assert.Equal(suite.T(), res.Provenance.CorrectionRuleScope, correctionRuleScopeMerchant)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestProvenance_GetRate() {
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+		CommonCorrection:  1,
+	})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.GetRateCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeOxr,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+	}
+	res := &currencies.RateData{}
+	err = suite.service.GetRateCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Provenance.RateId, res.Id)
+	assert.NotEmpty(suite.T(), res.Provenance.CorrectionRuleId)
+	assert.Equal(suite.T(), res.Provenance.CorrectionRuleScope, correctionRuleScopeCommon)
+
+	// the rate of the same currencies has no document
+	req.To = "USD"
+	res = &currencies.RateData{}
+	err = suite.service.GetRateCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), res.Provenance.RateId)
+	assert.Equal(suite.T(), res.Provenance.Source, stubSource)
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	quarantinedRatesDefaultLimit = 100
+
+	errorQuarantinedRatesNotFound     = "quarantined rates batch not found"
+	errorQuarantinedRatesReviewed     = "quarantined rates batch already reviewed"
+	errorQuarantinedRatesReviewFailed = "quarantined rates batch review failed"
+)
+
+// ListQuarantinedRates - returns batches of rates held back because of anomalies, the latest first
+func (s *Service) ListQuarantinedRates(
+	ctx context.Context,
+	req *currencies.QuarantinedRatesRequest,
+	res *currencies.QuarantinedRatesResponse,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	query := bson.M{"status": quarantineStatusPending}
+	if req.Status != "" {
+		query["status"] = req.Status
+	}
+	if req.Source != "" {
+		query["source"] = req.Source
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 {
+		limit = quarantinedRatesDefaultLimit
+	}
+
+	var items []*quarantinedBatch
+	err := s.db.Collection(collectionNameQuarantinedRates).Find(query).
+		Sort("-created_at", "-_id").
+		Skip(int(req.Offset)).
+		Limit(limit).
+		All(&items)
+
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameQuarantinedRates),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return err
+	}
+
+	for _, item := range items {
+		res.Items = append(res.Items, s.toQuarantinedRatesProto(item))
+	}
+
+	return nil
+}
+
+// ApproveQuarantinedRates - saves the rates of quarantined batch and recalculates rate types derived from them
+func (s *Service) ApproveQuarantinedRates(
+	ctx context.Context,
+	req *currencies.QuarantinedRatesReviewRequest,
+	res *currencies.EmptyResponse,
+) error {
+	batch, err := s.reviewQuarantinedRates(req, quarantineStatusApproved)
+	if err != nil {
+		return err
+	}
+
+	rates := make([]interface{}, len(batch.Rates))
+	for i, rd := range batch.Rates {
+		rates[i] = rd
+	}
+
+	// the rates keep the ids assigned on quarantine, so the rates saved by a failed approve aren't duplicated
+	err = s.saveRatesOnce(batch.RateType, rates)
+	if err != nil {
+		zap.S().Errorw(errorRatesSaveFailed, "error", err, "batch", req.Id)
+		s.sendCentrifugoMessage(errorRatesSaveFailed, err)
+
+		// the batch is returned to review, so it can be approved again
+		_ = s.db.Collection(collectionNameQuarantinedRates).UpdateId(
+			batch.Id,
+			bson.M{"$set": bson.M{"status": quarantineStatusPending}, "$unset": bson.M{"reviewed_by": "", "reviewed_at": ""}},
+		)
+		return err
+	}
+
+	for _, rt := range derivedRateTypes[batch.RateType] {
+		if err = s.RequestRatesByType(rt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RejectQuarantinedRates - discards the rates of quarantined batch
+func (s *Service) RejectQuarantinedRates(
+	ctx context.Context,
+	req *currencies.QuarantinedRatesReviewRequest,
+	res *currencies.EmptyResponse,
+) error {
+	_, err := s.reviewQuarantinedRates(req, quarantineStatusRejected)
+	return err
+}
+
+// reviewQuarantinedRates - records the decision on pending batch with the operator id,
+// the batch that has been reviewed already can't be reviewed again
+func (s *Service) reviewQuarantinedRates(
+	req *currencies.QuarantinedRatesReviewRequest,
+	status string,
+) (*quarantinedBatch, error) {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return nil, err
+	}
+
+	query := bson.M{"_id": bson.ObjectIdHex(req.Id), "status": quarantineStatusPending}
+	change := mgo.Change{
+		Update: bson.M{"$set": bson.M{
+			"status":      status,
+			"reviewed_by": req.UserId,
+			"reviewed_at": time.Now(),
+			"comment":     req.Comment,
+		}},
+		ReturnNew: true,
+	}
+
+	batch := &quarantinedBatch{}
+	_, err := s.db.Collection(collectionNameQuarantinedRates).Find(query).Apply(change, batch)
+
+	if err == mgo.ErrNotFound {
+		n, _ := s.db.Collection(collectionNameQuarantinedRates).FindId(bson.ObjectIdHex(req.Id)).Count()
+		if n > 0 {
+			zap.S().Errorw(errorQuarantinedRatesReviewed, "req", req)
+			return nil, errors.New(errorQuarantinedRatesReviewed)
+		}
+		zap.S().Errorw(errorQuarantinedRatesNotFound, "req", req)
+		return nil, errors.New(errorQuarantinedRatesNotFound)
+	}
+
+	if err != nil {
+		zap.L().Error(
+			errorQuarantinedRatesReviewFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameQuarantinedRates),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return nil, err
+	}
+
+	zap.S().Infow("Quarantined rates reviewed", "batch", req.Id, "status", status, "user", req.UserId)
+
+	return batch, nil
+}
+
+func (s *Service) toQuarantinedRatesProto(item *quarantinedBatch) *currencies.QuarantinedRates {
+	res := &currencies.QuarantinedRates{
+		Id:         item.Id.Hex(),
+		Source:     item.Source,
+		RateType:   item.RateType,
+		Rates:      item.Rates,
+		Status:     item.Status,
+		ReviewedBy: item.ReviewedBy,
+		Comment:    item.Comment,
+	}
+
+	res.CreatedAt, _ = ptypes.TimestampProto(item.CreatedAt)
+	if item.ReviewedAt != nil {
+		res.ReviewedAt, _ = ptypes.TimestampProto(*item.ReviewedAt)
+	}
+
+	for _, a := range item.Anomalies {
+		res.Anomalies = append(res.Anomalies, &currencies.RateAnomaly{
+			Pair:          a.Pair,
+			Rate:          a.Rate,
+			Reference:     a.Reference,
+			ReferenceRate: a.ReferenceRate,
+			Deviation:     a.Deviation,
+			Threshold:     a.Threshold,
+		})
+	}
+
+	return res
+}
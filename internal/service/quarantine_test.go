@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) insertQuarantinedBatch(rateType, source string) *quarantinedBatch {
+	batch := &quarantinedBatch{
+		Id:       bson.NewObjectId(),
+		Source:   source,
+		RateType: rateType,
+		Rates: []*currencies.RateData{
+			{Pair: "USDRUB", Rate: r * 2, Source: source, Volume: 1},
+		},
+		Anomalies: []*rateAnomaly{
+			{Pair: "USDRUB", Rate: r * 2, Reference: anomalyReferencePrevious, ReferenceRate: r, Deviation: 100, Threshold: 10},
+		},
+		Status:    quarantineStatusPending,
+		CreatedAt: time.Now(),
+	}
+	err := suite.service.db.Collection(collectionNameQuarantinedRates).Insert(batch)
+	assert.NoError(suite.T(), err)
+	return batch
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestQuarantine_ListQuarantinedRates() {
+	batch := suite.insertQuarantinedBatch(currencies.RateTypeCentralbanks, cbrfSource)
+	suite.insertQuarantinedBatch(currencies.RateTypeCentralbanks, cbeuSource)
+
+	res := &currencies.QuarantinedRatesResponse{}
+	err := suite.service.ListQuarantinedRates(context.TODO(), &currencies.QuarantinedRatesRequest{}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 2)
+
+	res = &currencies.QuarantinedRatesResponse{}
+	req := &currencies.QuarantinedRatesRequest{Source: cbrfSource}
+	err = suite.service.ListQuarantinedRates(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Items[0].Id, batch.Id.Hex())
+	assert.Len(suite.T(), res.Items[0].Rates, 1)
+	assert.Len(suite.T(), res.Items[0].Anomalies, 1)
+	assert.Equal(suite.T(), res.Items[0].Anomalies[0].ReferenceRate, r)
+
+	res = &currencies.QuarantinedRatesResponse{}
+	req = &currencies.QuarantinedRatesRequest{Status: quarantineStatusApproved}
+	err = suite.service.ListQuarantinedRates(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 0)
+
+	req = &currencies.QuarantinedRatesRequest{Status: "bla-bla"}
+	err = suite.service.ListQuarantinedRates(context.TODO(), req, res)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestQuarantine_ApproveQuarantinedRates() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+
+	batch := suite.insertQuarantinedBatch(currencies.RateTypeCentralbanks, cbrfSource)
+
+	req := &currencies.QuarantinedRatesReviewRequest{Id: batch.Id.Hex(), UserId: "operator", Comment: "ruble crash"}
+	err = suite.service.ApproveQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	rd := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeCentralbanks, "USD", "RUB", bson.M{}, cbrfSource, rd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r*2)
+
+	res := &currencies.QuarantinedRatesResponse{}
+	err = suite.service.ListQuarantinedRates(context.TODO(), &currencies.QuarantinedRatesRequest{Status: quarantineStatusApproved}, res)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), res.Items, 1)
+	assert.Equal(suite.T(), res.Items[0].ReviewedBy, "operator")
+	assert.Equal(suite.T(), res.Items[0].Comment, "ruble crash")
+	assert.NotNil(suite.T(), res.Items[0].ReviewedAt)
+
+	// the decision can't be changed
+	err = suite.service.RejectQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorQuarantinedRatesReviewed)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestQuarantine_ApproveQuarantinedRates_PartiallySaved() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+
+	batch := suite.insertQuarantinedBatch(currencies.RateTypeCentralbanks, cbrfSource)
+
+	// the rate saved by the failed approve has the id assigned on quarantine
+	stored := &quarantinedBatch{}
+	err = suite.service.db.Collection(collectionNameQuarantinedRates).FindId(batch.Id).One(stored)
+	assert.NoError(suite.T(), err)
+	assert.NotEmpty(suite.T(), stored.Rates[0].Id)
+	err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, []interface{}{stored.Rates[0]})
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.QuarantinedRatesReviewRequest{Id: batch.Id.Hex(), UserId: "operator"}
+	err = suite.service.ApproveQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	cName, err := suite.service.getCollectionName(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+	n, err := suite.service.db.Collection(cName).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), n, 1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestQuarantine_RejectQuarantinedRates() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+
+	batch := suite.insertQuarantinedBatch(currencies.RateTypeCentralbanks, cbrfSource)
+
+	req := &currencies.QuarantinedRatesReviewRequest{Id: batch.Id.Hex(), UserId: "operator"}
+	err = suite.service.RejectQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.NoError(suite.T(), err)
+
+	cName, err := suite.service.getCollectionName(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+	n, err := suite.service.db.Collection(cName).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), n, 0)
+
+	saved := &quarantinedBatch{}
+	err = suite.service.db.Collection(collectionNameQuarantinedRates).FindId(batch.Id).One(saved)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), saved.Status, quarantineStatusRejected)
+	assert.Equal(suite.T(), saved.ReviewedBy, "operator")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestQuarantine_Review_Fail() {
+	req := &currencies.QuarantinedRatesReviewRequest{Id: bson.NewObjectId().Hex()}
+	err := suite.service.RejectQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+
+	req.UserId = "operator"
+	err = suite.service.ApproveQuarantinedRates(context.TODO(), req, &currencies.EmptyResponse{})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorQuarantinedRatesNotFound)
+}
@@ -0,0 +1,341 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"gopkg.in/go-playground/validator.v9"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	restPathRates      = "/rates/"
+	restPathExchange   = "/exchange"
+	restPathCurrencies = "/currencies"
+
+	restDateLayout = "2006-01-02"
+
+	// the exchange request is a few short fields, a larger body is refused without reading it to the end
+	restBodyMaxBytes = 1 << 14
+
+	restCurrenciesKindSupported  = "supported"
+	restCurrenciesKindSettlement = "settlement"
+	restCurrenciesKindPrice      = "price"
+	restCurrenciesKindVat        = "vat"
+	restCurrenciesKindAccounting = "accounting"
+
+	errorRestMethodNotAllowed = "method not allowed"
+	errorRestPathInvalid      = "path invalid, expected /rates/{type}/{from}/{to}"
+	errorRestDateInvalid      = "date invalid, expected YYYY-MM-DD or RFC 3339 time"
+	errorRestBodyInvalid      = "request body invalid"
+	errorRestCurrenciesKind   = "currencies kind invalid"
+	errorRestResponseWrite    = "rest response write failed"
+)
+
+// restExchangeRequest - body of POST /exchange, merchant_id and datetime are optional
+type restExchangeRequest struct {
+	From              string  `json:"from"`
+	To                string  `json:"to"`
+	RateType          string  `json:"rate_type"`
+	Source            string  `json:"source"`
+	Amount            float64 `json:"amount"`
+	ExchangeDirection string  `json:"exchange_direction"`
+	RoundingMode      string  `json:"rounding_mode"`
+	MerchantId        string  `json:"merchant_id"`
+	Datetime          string  `json:"datetime"`
+}
+
+type restError struct {
+	Error string `json:"error"`
+}
+
+// NewRestHandler - returns HTTP/JSON gateway to the rates, exchange and currencies RPCs.
+// The requests are validated by the tags of the RPC requests once here, then the RPC methods are called
+func (s *Service) NewRestHandler() http.Handler {
+	router := http.NewServeMux()
+	router.HandleFunc(restPathRates, s.restGetRate)
+	router.HandleFunc(restPathExchange, s.restExchangeCurrency)
+	router.HandleFunc(restPathCurrencies, s.restGetCurrencies)
+	return router
+}
+
+// restGetRate - GET /rates/{type}/{from}/{to}?date=&merchant=&direction=&source=
+func (s *Service) restGetRate(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeRestError(w, http.StatusMethodNotAllowed, errors.New(errorRestMethodNotAllowed))
+		return
+	}
+
+	parts := strings.Split(strings.Trim(strings.TrimPrefix(r.URL.Path, restPathRates), "/"), "/")
+	if len(parts) != 3 {
+		s.writeRestError(w, http.StatusNotFound, errors.New(errorRestPathInvalid))
+		return
+	}
+
+	rateType, from, to := parts[0], strings.ToUpper(parts[1]), strings.ToUpper(parts[2])
+	params := r.URL.Query()
+	source := params.Get("source")
+	direction := params.Get("direction")
+	merchantId := params.Get("merchant")
+
+	dt, err := s.parseRestDate(params.Get("date"))
+	if err != nil {
+		s.writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	var req interface{}
+	switch {
+	case dt == nil && merchantId == "":
+		req = &currencies.GetRateCurrentCommonRequest{
+			From: from, To: to, RateType: rateType, Source: source, ExchangeDirection: direction,
+		}
+	case dt == nil:
+		req = &currencies.GetRateCurrentForMerchantRequest{
+			From: from, To: to, RateType: rateType, Source: source, ExchangeDirection: direction, MerchantId: merchantId,
+		}
+	case merchantId == "":
+		req = &currencies.GetRateByDateCommonRequest{
+			From: from, To: to, RateType: rateType, Source: source, ExchangeDirection: direction, Datetime: dt,
+		}
+	default:
+		req = &currencies.GetRateByDateForMerchantRequest{
+			From:              from,
+			To:                to,
+			RateType:          rateType,
+			Source:            source,
+			ExchangeDirection: direction,
+			MerchantId:        merchantId,
+			Datetime:          dt,
+		}
+	}
+
+	if err = s.validateReq(req); err != nil {
+		s.writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := r.Context()
+	res := &currencies.RateData{}
+
+	switch req := req.(type) {
+	case *currencies.GetRateCurrentCommonRequest:
+		err = s.GetRateCurrentCommon(ctx, req, res)
+	case *currencies.GetRateCurrentForMerchantRequest:
+		err = s.GetRateCurrentForMerchant(ctx, req, res)
+	case *currencies.GetRateByDateCommonRequest:
+		err = s.GetRateByDateCommon(ctx, req, res)
+	case *currencies.GetRateByDateForMerchantRequest:
+		err = s.GetRateByDateForMerchant(ctx, req, res)
+	}
+
+	if err != nil {
+		s.writeRestError(w, s.getRestErrorStatus(err), err)
+		return
+	}
+
+	s.writeRestResponse(w, http.StatusOK, res)
+}
+
+// restExchangeCurrency - POST /exchange with restExchangeRequest body
+func (s *Service) restExchangeCurrency(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		s.writeRestError(w, http.StatusMethodNotAllowed, errors.New(errorRestMethodNotAllowed))
+		return
+	}
+
+	// unknown fields are refused, so a misspelled optional field like merchant_id isn't silently ignored
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, restBodyMaxBytes))
+	decoder.DisallowUnknownFields()
+
+	body := &restExchangeRequest{}
+	if err := decoder.Decode(body); err != nil {
+		s.writeRestError(w, http.StatusBadRequest, errors.New(errorRestBodyInvalid))
+		return
+	}
+
+	dt, err := s.parseRestDate(body.Datetime)
+	if err != nil {
+		s.writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	from, to := strings.ToUpper(body.From), strings.ToUpper(body.To)
+
+	var req interface{}
+	switch {
+	case dt == nil && body.MerchantId == "":
+		req = &currencies.ExchangeCurrencyCurrentCommonRequest{
+			From:              from,
+			To:                to,
+			RateType:          body.RateType,
+			Source:            body.Source,
+			Amount:            body.Amount,
+			ExchangeDirection: body.ExchangeDirection,
+			RoundingMode:      body.RoundingMode,
+		}
+	case dt == nil:
+		req = &currencies.ExchangeCurrencyCurrentForMerchantRequest{
+			From:              from,
+			To:                to,
+			RateType:          body.RateType,
+			Source:            body.Source,
+			Amount:            body.Amount,
+			ExchangeDirection: body.ExchangeDirection,
+			RoundingMode:      body.RoundingMode,
+			MerchantId:        body.MerchantId,
+		}
+	case body.MerchantId == "":
+		req = &currencies.ExchangeCurrencyByDateCommonRequest{
+			From:              from,
+			To:                to,
+			RateType:          body.RateType,
+			Source:            body.Source,
+			Amount:            body.Amount,
+			ExchangeDirection: body.ExchangeDirection,
+			RoundingMode:      body.RoundingMode,
+			Datetime:          dt,
+		}
+	default:
+		req = &currencies.ExchangeCurrencyByDateForMerchantRequest{
+			From:              from,
+			To:                to,
+			RateType:          body.RateType,
+			Source:            body.Source,
+			Amount:            body.Amount,
+			ExchangeDirection: body.ExchangeDirection,
+			RoundingMode:      body.RoundingMode,
+			MerchantId:        body.MerchantId,
+			Datetime:          dt,
+		}
+	}
+
+	if err = s.validateReq(req); err != nil {
+		s.writeRestError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	ctx := r.Context()
+	res := &currencies.ExchangeCurrencyResponse{}
+
+	switch req := req.(type) {
+	case *currencies.ExchangeCurrencyCurrentCommonRequest:
+		err = s.ExchangeCurrencyCurrentCommon(ctx, req, res)
+	case *currencies.ExchangeCurrencyCurrentForMerchantRequest:
+		err = s.ExchangeCurrencyCurrentForMerchant(ctx, req, res)
+	case *currencies.ExchangeCurrencyByDateCommonRequest:
+		err = s.ExchangeCurrencyByDateCommon(ctx, req, res)
+	case *currencies.ExchangeCurrencyByDateForMerchantRequest:
+		err = s.ExchangeCurrencyByDateForMerchant(ctx, req, res)
+	}
+
+	if err != nil {
+		s.writeRestError(w, s.getRestErrorStatus(err), err)
+		return
+	}
+
+	s.writeRestResponse(w, http.StatusOK, res)
+}
+
+// restGetCurrencies - GET /currencies?kind=, all supported currencies if kind is empty
+func (s *Service) restGetCurrencies(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		s.writeRestError(w, http.StatusMethodNotAllowed, errors.New(errorRestMethodNotAllowed))
+		return
+	}
+
+	var handler func(context.Context, *currencies.EmptyRequest, *currencies.CurrenciesList) error
+
+	switch r.URL.Query().Get("kind") {
+	case "", restCurrenciesKindSupported:
+		handler = s.GetSupportedCurrencies
+	case restCurrenciesKindSettlement:
+		handler = s.GetSettlementCurrencies
+	case restCurrenciesKindPrice:
+		handler = s.GetPriceCurrencies
+	case restCurrenciesKindVat:
+		handler = s.GetVatCurrencies
+	case restCurrenciesKindAccounting:
+		handler = s.GetAccountingCurrencies
+	default:
+		s.writeRestError(w, http.StatusBadRequest, errors.New(errorRestCurrenciesKind))
+		return
+	}
+
+	res := &currencies.CurrenciesList{}
+	if err := handler(r.Context(), &currencies.EmptyRequest{}, res); err != nil {
+		s.writeRestError(w, s.getRestErrorStatus(err), err)
+		return
+	}
+
+	s.writeRestResponse(w, http.StatusOK, res)
+}
+
+// parseRestDate - parses date in YYYY-MM-DD format or time in RFC 3339 format, nil for empty value
+func (s *Service) parseRestDate(value string) (*timestamp.Timestamp, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t, err = time.Parse(restDateLayout, value)
+	}
+	if err != nil {
+		return nil, errors.New(errorRestDateInvalid)
+	}
+
+	return ptypes.TimestampProto(t)
+}
+
+// getRestErrorStatus - returns HTTP status of the RPC error, the errors of the request are client errors
+func (s *Service) getRestErrorStatus(err error) int {
+	if err == mgo.ErrNotFound {
+		return http.StatusNotFound
+	}
+
+	if _, ok := err.(validator.ValidationErrors); ok {
+		return http.StatusBadRequest
+	}
+
+	switch err {
+	case errMerchantIdRequired,
+		errFromCurrencyNotSupported,
+		errToCurrencyNotSupported,
+		errRateTypeInvalid,
+		errExchangeDirectionInvalid:
+		return http.StatusBadRequest
+	}
+
+	return http.StatusInternalServerError
+}
+
+func (s *Service) writeRestError(w http.ResponseWriter, status int, err error) {
+	w.Header().Set(headerContentType, mimeApplicationJSON)
+	w.WriteHeader(status)
+
+	if err := json.NewEncoder(w).Encode(&restError{Error: err.Error()}); err != nil {
+		zap.S().Errorw(errorRestResponseWrite, "error", err)
+	}
+}
+
+// writeRestResponse - writes the RPC response with the proto JSON mapping, so the timestamps are RFC 3339 strings
+// and the fields have the names of the proto file
+func (s *Service) writeRestResponse(w http.ResponseWriter, status int, res proto.Message) {
+	w.Header().Set(headerContentType, mimeApplicationJSON)
+	w.WriteHeader(status)
+
+	m := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	if err := m.Marshal(w, res); err != nil {
+		zap.S().Errorw(errorRestResponseWrite, "error", err)
+	}
+}
@@ -0,0 +1,120 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"github.com/golang/protobuf/jsonpb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestRest_GetRate_Ok() {
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/rates/oxr/usd/rub?direction=sell", nil)
+	suite.service.NewRestHandler().ServeHTTP(rec, req)
+
+	assert.Equal(suite.T(), rec.Code, http.StatusOK)
+	assert.Equal(suite.T(), rec.Header().Get(headerContentType), mimeApplicationJSON)
+
+	res := &currencies.RateData{}
+	err := jsonpb.Unmarshal(bytes.NewReader(rec.Body.Bytes()), res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Pair, "USDRUB")
+	assert.Equal(suite.T(), res.Rate, r)
+	assert.NotNil(suite.T(), res.CreatedAt)
+
+	// the timestamps are written as RFC 3339 strings
+	raw := map[string]interface{}{}
+	err = json.Unmarshal(rec.Body.Bytes(), &raw)
+	assert.NoError(suite.T(), err)
+	assert.IsType(suite.T(), raw["created_at"], "")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestRest_GetRate_Fail() {
+	handler := suite.service.NewRestHandler()
+
+	cases := map[string]int{
+		"/rates/oxr/usd?direction=sell":                http.StatusNotFound,
+		"/rates/oxr/usd/rub":                           http.StatusBadRequest,
+		"/rates/oxr/xxx/rub?direction=sell":            http.StatusBadRequest,
+		"/rates/unknown/usd/rub?direction=sell":        http.StatusBadRequest,
+		"/rates/oxr/usd/rub?direction=sell&date=1 May": http.StatusBadRequest,
+		"/rates/oxr/usd/rub?direction=sell&merchant=1": http.StatusBadRequest,
+		"/rates/oxr/usd/xxx?direction=sell":            http.StatusBadRequest,
+	}
+
+	for url, status := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, url, nil))
+		assert.Equal(suite.T(), rec.Code, status, url)
+
+		res := &restError{}
+		err := json.Unmarshal(rec.Body.Bytes(), res)
+		assert.NoError(suite.T(), err)
+		assert.NotEmpty(suite.T(), res.Error)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/rates/oxr/usd/rub", nil))
+	assert.Equal(suite.T(), rec.Code, http.StatusMethodNotAllowed)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestRest_ExchangeCurrency_Ok() {
+	body := `{"from":"USD","to":"RUB","rate_type":"oxr","amount":100,"exchange_direction":"sell"}`
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/exchange", strings.NewReader(body))
+	suite.service.NewRestHandler().ServeHTTP(rec, req)
+
+	assert.Equal(suite.T(), rec.Code, http.StatusOK)
+
+	res := &currencies.ExchangeCurrencyResponse{}
+	err := jsonpb.Unmarshal(bytes.NewReader(rec.Body.Bytes()), res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangedAmount, float64(6463.14))
+	assert.Equal(suite.T(), res.OriginalRate, r)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestRest_ExchangeCurrency_Fail() {
+	handler := suite.service.NewRestHandler()
+
+	cases := []string{
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":100`,
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":-1,"exchange_direction":"sell"}`,
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":100,"exchange_direction":"up"}`,
+		`{"from":"USD","to":"XXX","rate_type":"oxr","amount":100,"exchange_direction":"sell"}`,
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":100,"exchange_direction":"sell","datetime":"now"}`,
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":100,"exchange_direction":"sell","merchant":"5d8a3e2c"}`,
+		`{"from":"USD","to":"RUB","rate_type":"oxr","amount":100,"exchange_direction":"sell","source":"` + strings.Repeat("A", restBodyMaxBytes) + `"}`,
+	}
+
+	for _, body := range cases {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/exchange", strings.NewReader(body)))
+		assert.Equal(suite.T(), rec.Code, http.StatusBadRequest, body)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/exchange", nil))
+	assert.Equal(suite.T(), rec.Code, http.StatusMethodNotAllowed)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestRest_GetCurrencies() {
+	handler := suite.service.NewRestHandler()
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/currencies?kind=price", nil))
+	assert.Equal(suite.T(), rec.Code, http.StatusOK)
+
+	res := &currencies.CurrenciesList{}
+	err := jsonpb.Unmarshal(bytes.NewReader(rec.Body.Bytes()), res)
+	assert.NoError(suite.T(), err)
+	assert.ElementsMatch(suite.T(), res.Currencies, priceCurrencies)
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/currencies?kind=unknown", nil))
+	assert.Equal(suite.T(), rec.Code, http.StatusBadRequest)
+}
@@ -0,0 +1,255 @@
+package service
+
+import (
+	"errors"
+	"github.com/globalsign/mgo"
+	"github.com/globalsign/mgo/bson"
+	"github.com/paysuper/paysuper-currencies/internal/cron"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	"go.uber.org/zap"
+	"os"
+	"time"
+)
+
+const (
+	collectionNameTriggers = "triggers"
+
+	errorTriggerCronInvalid   = "trigger cron expression invalid"
+	errorTriggerNextRunFailed = "trigger next run time calculation failed"
+	errorTriggerLockFailed    = "trigger lock failed"
+	errorTriggerUpdateFailed  = "trigger state update failed"
+	errorTriggerRunFailed     = "scheduled rates request failed"
+	errorTriggersLoadFailed   = "triggers load failed"
+)
+
+// trigger - schedule of rates requests for a rate type or a single rates source
+type trigger struct {
+	Id          bson.ObjectId `bson:"_id"`
+	Type        string        `bson:"type"`
+	Cron        string        `bson:"cron"`
+	Enabled     bool          `bson:"enabled"`
+	LockedBy    string        `bson:"locked_by,omitempty"`
+	LockedUntil time.Time     `bson:"locked_until,omitempty"`
+	LastRunAt   time.Time     `bson:"last_run_at,omitempty"`
+	LastError   string        `bson:"last_error"`
+	NextRunAt   time.Time     `bson:"next_run_at,omitempty"`
+}
+
+func (s *Service) startScheduler() {
+	if !s.getConfig().SchedulerEnabled {
+		return
+	}
+
+	s.schedulerId, _ = os.Hostname()
+	s.schedulerId += "-" + bson.NewObjectId().Hex()
+	s.schedulerStop = make(chan bool)
+	s.schedulerDone = make(chan bool)
+
+	zap.S().Infow("Rates scheduler started", "id", s.schedulerId)
+
+	// the goroutine gets own copy of the channel, so it doesn't read the field concurrently with Stop
+	go func(stop <-chan bool, done chan<- bool) {
+		defer close(done)
+
+		ticker := time.NewTicker(time.Duration(s.getConfig().SchedulerCheckInterval) * time.Second)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				s.runTriggers(time.Now())
+			case <-stop:
+				zap.S().Info("Rates scheduler stopped")
+				return
+			}
+		}
+	}(s.schedulerStop, s.schedulerDone)
+}
+
+// Stop - stops background processes of the service and waits for the running triggers, repeated calls do nothing
+func (s *Service) Stop() {
+	s.stopOnce.Do(func() {
+		if s.schedulerStop != nil {
+			close(s.schedulerStop)
+		}
+
+		if s.currenciesReloadStop != nil {
+			close(s.currenciesReloadStop)
+		}
+
+		if s.cacheVersionsStop != nil {
+			close(s.cacheVersionsStop)
+		}
+
+		// the scheduler loop doesn't start new triggers after it's done, so the wait doesn't race with adding them,
+		// and the triggers release their locks before the caller closes the db
+		if s.schedulerDone != nil {
+			<-s.schedulerDone
+		}
+
+		s.triggersWg.Wait()
+	})
+}
+
+func (s *Service) runTriggers(now time.Time) {
+	var triggers []*trigger
+
+	err := s.db.Collection(collectionNameTriggers).Find(bson.M{"enabled": true}).All(&triggers)
+	if err != nil {
+		zap.L().Error(
+			pkg.ErrorDatabaseQueryFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameTriggers),
+		)
+		s.sendCentrifugoMessage(errorTriggersLoadFailed, err)
+		return
+	}
+
+	for _, t := range triggers {
+		// trigger never run before, schedule it from now
+		if t.NextRunAt.IsZero() {
+			if err = s.updateTriggerNextRun(t, now); err != nil {
+				continue
+			}
+		}
+
+		if t.NextRunAt.After(now) {
+			continue
+		}
+
+		locked, err := s.lockTrigger(t, now)
+		if err != nil || !locked {
+			continue
+		}
+
+		// a long rates request doesn't delay other triggers, the lock keeps other replicas from running the trigger
+		s.triggersWg.Add(1)
+		go func(t *trigger) {
+			defer s.triggersWg.Done()
+			s.runTrigger(t, now)
+		}(t)
+	}
+}
+
+func (s *Service) runTrigger(t *trigger, now time.Time) {
+	state := bson.M{"last_run_at": now, "last_error": ""}
+	unset := bson.M{"locked_by": "", "locked_until": ""}
+
+	// the lock is released on any result, so the trigger doesn't wait for the lock timeout
+	defer s.unlockTrigger(t, state, unset)
+
+	zap.S().Infow("Running scheduled rates request", "type", t.Type, "scheduledAt", t.NextRunAt)
+
+	err := s.RequestRatesBySourceOrType(t.Type)
+	if err != nil {
+		zap.S().Errorw(errorTriggerRunFailed, "error", err, "type", t.Type)
+		state["last_error"] = err.Error()
+	}
+
+	next, err := s.getTriggerNextRun(t, time.Now())
+	if err != nil {
+		// the trigger is not run until its cron is fixed, as a new trigger with invalid cron
+		state["last_error"] = err.Error()
+		unset["next_run_at"] = ""
+		return
+	}
+
+	state["next_run_at"] = next
+}
+
+// unlockTrigger - saves the state of the trigger run and releases the lock of this replica
+func (s *Service) unlockTrigger(t *trigger, state, unset bson.M) {
+	query := bson.M{"_id": t.Id, "locked_by": s.schedulerId}
+	set := bson.M{"$set": state, "$unset": unset}
+
+	err := s.db.Collection(collectionNameTriggers).Update(query, set)
+	if err != nil {
+		zap.L().Error(
+			errorTriggerUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameTriggers),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+	}
+}
+
+// lockTrigger - acquires the trigger lock, so the only one replica of the service will run it
+func (s *Service) lockTrigger(t *trigger, now time.Time) (bool, error) {
+	query := bson.M{
+		"_id":         t.Id,
+		"next_run_at": t.NextRunAt,
+		"$or": []bson.M{
+			{"locked_until": bson.M{"$exists": false}},
+			{"locked_until": bson.M{"$lte": now}},
+		},
+	}
+	change := mgo.Change{
+		Update: bson.M{
+			"$set": bson.M{
+				"locked_by":    s.schedulerId,
+				"locked_until": now.Add(time.Duration(s.getConfig().SchedulerLockTimeout) * time.Second),
+			},
+		},
+		ReturnNew: true,
+	}
+
+	_, err := s.db.Collection(collectionNameTriggers).Find(query).Apply(change, t)
+	if err == mgo.ErrNotFound {
+		// trigger already locked by another replica, or it's already run
+		return false, nil
+	}
+
+	if err != nil {
+		zap.L().Error(
+			errorTriggerLockFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameTriggers),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+		)
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (s *Service) updateTriggerNextRun(t *trigger, now time.Time) error {
+	next, err := s.getTriggerNextRun(t, now)
+	if err != nil {
+		return err
+	}
+
+	query := bson.M{"_id": t.Id}
+	set := bson.M{"$set": bson.M{"next_run_at": next}}
+
+	err = s.db.Collection(collectionNameTriggers).Update(query, set)
+	if err != nil {
+		zap.L().Error(
+			errorTriggerUpdateFailed,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameTriggers),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, query),
+			zap.Any(pkg.ErrorDatabaseFieldSet, set),
+		)
+		return err
+	}
+
+	t.NextRunAt = next
+	return nil
+}
+
+func (s *Service) getTriggerNextRun(t *trigger, now time.Time) (time.Time, error) {
+	schedule, err := cron.Parse(t.Cron)
+	if err != nil {
+		zap.S().Errorw(errorTriggerCronInvalid, "error", err, "type", t.Type, "cron", t.Cron)
+		return time.Time{}, err
+	}
+
+	next := schedule.Next(now)
+	if next.IsZero() {
+		zap.S().Errorw(errorTriggerNextRunFailed, "type", t.Type, "cron", t.Cron)
+		return time.Time{}, errors.New(errorTriggerNextRunFailed)
+	}
+
+	return next, nil
+}
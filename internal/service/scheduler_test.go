@@ -0,0 +1,135 @@
+package service
+
+import (
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+const schedulerTestSource = "SCHEDULERTEST"
+
+func newSchedulerTestSource() RateSource {
+	return NewRateSource(schedulerTestSource, currencies.RateTypeStock, "", func(s *Service) ([]interface{}, error) {
+		return []interface{}{
+			&currencies.RateData{
+				Pair:   "USDRUB",
+				Rate:   r,
+				Source: schedulerTestSource,
+				Volume: 1,
+			},
+		}, nil
+	})
+}
+
+func (suite *CurrenciesratesServiceTestSuite) insertTrigger(cron string, nextRunAt time.Time) *trigger {
+	t := &trigger{
+		Id:        bson.NewObjectId(),
+		Type:      schedulerTestSource,
+		Cron:      cron,
+		Enabled:   true,
+		NextRunAt: nextRunAt,
+	}
+	err := suite.service.db.Collection(collectionNameTriggers).Insert(t)
+	assert.NoError(suite.T(), err)
+	return t
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_runTriggers_Ok() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixStock)
+	assert.NoError(suite.T(), err)
+
+	now := time.Now()
+	suite.service.schedulerId = "test"
+	t := suite.insertTrigger("* * * * *", now.Add(-time.Minute))
+
+	suite.service.runTriggers(now)
+	suite.service.triggersWg.Wait()
+
+	res := &trigger{}
+	err = suite.service.db.Collection(collectionNameTriggers).FindId(t.Id).One(res)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), res.LockedBy)
+	assert.Empty(suite.T(), res.LastError)
+	assert.False(suite.T(), res.LastRunAt.IsZero())
+	assert.True(suite.T(), res.NextRunAt.After(now))
+
+	rd := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeStock, "USD", "RUB", bson.M{}, "", rd)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Source, schedulerTestSource)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_runTriggers_NotDue() {
+	now := time.Now()
+	suite.service.schedulerId = "test"
+	t := suite.insertTrigger("* * * * *", time.Time{})
+
+	suite.service.runTriggers(now)
+	suite.service.triggersWg.Wait()
+
+	res := &trigger{}
+	err := suite.service.db.Collection(collectionNameTriggers).FindId(t.Id).One(res)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), res.LastRunAt.IsZero())
+	assert.True(suite.T(), res.NextRunAt.After(now))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_runTrigger_CronInvalid() {
+	now := time.Now()
+	suite.service.schedulerId = "test"
+	t := suite.insertTrigger("bla-bla", now)
+
+	locked, err := suite.service.lockTrigger(t, now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), locked)
+
+	suite.service.runTrigger(t, now)
+
+	// the lock is released, the trigger isn't run again until the cron is fixed
+	res := &trigger{}
+	err = suite.service.db.Collection(collectionNameTriggers).FindId(t.Id).One(res)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), res.LockedBy)
+	assert.NotEmpty(suite.T(), res.LastError)
+	assert.True(suite.T(), res.NextRunAt.IsZero())
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_lockTrigger() {
+	now := time.Now()
+	suite.service.schedulerId = "test"
+	t := suite.insertTrigger("* * * * *", now)
+
+	locked, err := suite.service.lockTrigger(t, now)
+	assert.NoError(suite.T(), err)
+	assert.True(suite.T(), locked)
+
+	// the same trigger can't be locked by another replica
+	t2 := &trigger{Id: t.Id, NextRunAt: t.NextRunAt}
+	locked, err = suite.service.lockTrigger(t2, now)
+	assert.NoError(suite.T(), err)
+	assert.False(suite.T(), locked)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_getTriggerNextRun_Fail() {
+	_, err := suite.service.getTriggerNextRun(&trigger{Cron: "bla-bla"}, time.Now())
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestScheduler_Stop() {
+	suite.service.getConfig().SchedulerEnabled = true
+	suite.service.startScheduler()
+	assert.NotNil(suite.T(), suite.service.schedulerStop)
+
+	suite.service.Stop()
+
+	_, ok := <-suite.service.schedulerStop
+	assert.False(suite.T(), ok)
+
+	// the scheduler loop is finished by the time Stop returns
+	_, ok = <-suite.service.schedulerDone
+	assert.False(suite.T(), ok)
+
+	// repeated stop doesn't close the closed channel
+	assert.NotPanics(suite.T(), suite.service.Stop)
+}
@@ -12,20 +12,23 @@ import (
 	"github.com/globalsign/mgo"
 	"github.com/globalsign/mgo/bson"
 	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-currencies/config"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
 	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/paysuper/paysuper-database-mongo"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
 	tools "github.com/paysuper/paysuper-tools/http"
 	"go.uber.org/zap"
 	"golang.org/x/net/html/charset"
 	"gopkg.in/go-playground/validator.v9"
 	"io/ioutil"
-	"math"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
@@ -47,6 +50,7 @@ const (
 	errorCurrencyPairNotExists    = "currency pair is not exists"
 	errorDatetimeConversion       = "datetime conversion failed for central bank rate request"
 	errorCorrectionRuleNotFound   = "correction rule not found"
+	errorCorrectionRuleValidity   = "correction rule validity period invalid"
 
 	mimeApplicationJSON = "application/json"
 	mimeApplicationXML  = "application/xhtml+xml,application/xml"
@@ -64,11 +68,16 @@ const (
 	collectionRatesNameSuffixCentralbanks = currencies.RateTypeCentralbanks
 	collectionRatesNameSuffixPaysuper     = currencies.RateTypePaysuper
 	collectionRatesNameSuffixStock        = currencies.RateTypeStock
+	collectionRatesNameSuffixCardpay      = currencies.RateTypeCardpay
 
-	collectionNamePaysuperCorrections = "paysuper_corrections"
-	collectionNameCorrectionRules     = "correction_rules"
+	collectionNamePaysuperCorrections    = "paysuper_corrections"
+	collectionNamePaysuperForecastParams = "paysuper_forecast_params"
+	collectionNamePaysuperCorridors      = "paysuper_corridors"
+	collectionNameStockSpreads           = "stock_spreads"
+	collectionNameCorrectionRules        = "correction_rules"
 
-	ratesPrecision = 6
+	ratesPrecision    = 6
+	ratesRoundingMode = decimal.RoundCeil
 
 	dateFormatLayout = "2006-01-02"
 
@@ -77,34 +86,52 @@ const (
 
 	stubSource               = "STUB"
 	defaultHttpClientTimeout = 30
+
+	validatorTagRateType          = "rate_type"
+	validatorTagRateSource        = "rate_source"
+	validatorTagCentralbankSource = "centralbank_source"
+	validatorTagRoundingMode      = "rounding_mode"
 )
 
+// errors of the request data, the request fails with them on any retry
 var (
-	availableCentralbanksSources = map[string]bool{
-		cbeuSource: true,
-		cbauSource: true,
-		cbcaSource: true,
-		cbplSource: true,
-		cbrfSource: true,
-	}
+	errMerchantIdRequired       = errors.New(errorMerchantIdRequired)
+	errFromCurrencyNotSupported = errors.New(errorFromCurrencyNotSupported)
+	errToCurrencyNotSupported   = errors.New(errorToCurrencyNotSupported)
+	errRateTypeInvalid          = errors.New(errorRateTypeInvalid)
+	errExchangeDirectionInvalid = errors.New(errorExchangeDirectionInvalid)
 )
 
 // Service is application entry point.
 type Service struct {
-	cfg                 *config.Config
-	db                  *database.Source
-	centrifugoClient    *gocent.Client
-	validate            *validator.Validate
-	cardpayBroker       *rabbitmq.Broker
-	cardpayRetryBroker  *rabbitmq.Broker
-	cardpayFinishBroker *rabbitmq.Broker
+	// cfg holds *config.Config, the config is replaced as a whole on currencies reload, use getConfig to read it
+	cfg                  atomic.Value
+	currenciesMx         sync.Mutex
+	db                   *database.Source
+	centrifugoClient     *gocent.Client
+	validate             *validator.Validate
+	cardpayBroker        *rabbitmq.Broker
+	cardpayRetryBroker   *rabbitmq.Broker
+	cardpayFinishBroker  *rabbitmq.Broker
+	ratesUpdatesBroker   *rabbitmq.Broker
+	ratesUpdatesMx       sync.Mutex
+	schedulerId          string
+	schedulerStop        chan bool
+	schedulerDone        chan bool
+	triggersWg           sync.WaitGroup
+	currenciesReloadStop chan bool
+	cacheVersionsStop    chan bool
+	stopOnce             sync.Once
+	ratesSubscribers     map[*ratesSubscriber]bool
+	ratesSubscribersMx   sync.RWMutex
+	ratesCache           *memoryCache
+	rulesCache           *memoryCache
 }
 
 // NewService create new Service.
 func NewService(cfg *config.Config, db *database.Source) (*Service, error) {
 
-	return &Service{
-		cfg:      cfg,
+	s := &Service{
 		db:       db,
 		validate: validator.New(),
 		centrifugoClient: gocent.New(
@@ -114,11 +141,83 @@ func NewService(cfg *config.Config, db *database.Source) (*Service, error) {
 				HTTPClient: tools.NewLoggedHttpClient(zap.S()),
 			},
 		),
-	}, nil
+		ratesSubscribers: make(map[*ratesSubscriber]bool),
+		ratesCache:       newMemoryCache(cacheNameRates, time.Duration(cfg.CacheTtl)*time.Second, cfg.CacheSize),
+		rulesCache:       newMemoryCache(cacheNameCorrectionRules, time.Duration(cfg.CacheTtl)*time.Second, cfg.CacheSize),
+	}
+	s.cfg.Store(cfg)
+
+	err := decimal.ValidateRoundingMode(cfg.ExchangeRoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	if !anomalyActions[cfg.AnomalyAction] {
+		return nil, errors.New(errorAnomalyActionInvalid)
+	}
+
+	if !corridorActions[cfg.PaysuperCorridorAction] {
+		return nil, errors.New(errorCorridorActionInvalid)
+	}
+
+	if cfg.PaysuperForecastVolatilityFactor < 0 {
+		return nil, errors.New(errorPaysuperVolatilityValue)
+	}
+
+	err = s.validate.RegisterValidation(validatorTagRateType, s.validateRateType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validate.RegisterValidation(validatorTagRateSource, s.validateRateSource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validate.RegisterValidation(validatorTagCentralbankSource, s.validateCentralbankSource)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.validate.RegisterValidation(validatorTagRoundingMode, s.validateRoundingMode)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.loadCurrencies()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// getConfig - returns current config, it must not be changed, because it's shared by concurrent requests
+func (s *Service) getConfig() *config.Config {
+	return s.cfg.Load().(*config.Config)
 }
 
 // Init rabbitMq brokers and check for active triggers for delayed tasks
 func (s *Service) Init() error {
+	// the brokers are connected only when they are used, so the service runs without RabbitMQ otherwise
+	if s.getConfig().CardpayEnabled {
+		err := s.initCardpayBrokers()
+		if err != nil {
+			return err
+		}
+	}
+
+	if s.getConfig().RatesUpdatesEnabled {
+		err := s.initRatesUpdatesBroker(true)
+		if err != nil {
+			return err
+		}
+	}
+
+	s.startScheduler()
+	s.startCurrenciesReload()
+	s.startCacheVersionsCheck()
+
 	return nil
 }
 
@@ -139,6 +238,25 @@ func (s *Service) validateReq(req interface{}) error {
 	return nil
 }
 
+func (s *Service) validateRateType(fl validator.FieldLevel) bool {
+	return s.contains(s.getConfig().RatesTypes, fl.Field().String())
+}
+
+func (s *Service) validateRateSource(fl validator.FieldLevel) bool {
+	_, ok := GetRateSource(fl.Field().String())
+	return ok
+}
+
+// validateCentralbankSource - the source of rate requests selects one of central banks,
+// the rates of other types are requested without source
+func (s *Service) validateCentralbankSource(fl validator.FieldLevel) bool {
+	return s.isRateSourceOfType(currencies.RateTypeCentralbanks, fl.Field().String())
+}
+
+func (s *Service) validateRoundingMode(fl validator.FieldLevel) bool {
+	return decimal.ValidateRoundingMode(fl.Field().String()) == nil
+}
+
 func (s *Service) validateUrl(cUrl string) (*url.URL, error) {
 	if cUrl == "" {
 		return nil, errors.New(errorEmptyUrl)
@@ -225,7 +343,7 @@ func (s *Service) isPairExists(pair string) bool {
 }
 
 func (s *Service) isCurrencySupported(cur string) bool {
-	return s.contains(s.cfg.SupportedCurrenciesParsed, cur)
+	return s.contains(s.getConfig().SupportedCurrenciesParsed, cur)
 }
 
 func (s *Service) contains(slice map[string]bool, item string) bool {
@@ -239,29 +357,34 @@ func (s *Service) getRateByDate(collectionRatesNameSuffix string, from string, t
 
 func (s *Service) getRate(collectionRatesNameSuffix string, from string, to string, query bson.M, source string, res *currencies.RateData) error {
 
-	var err error
-
 	if !s.isCurrencySupported(from) {
-		return errors.New(errorFromCurrencyNotSupported)
+		return errFromCurrencyNotSupported
 	}
 	if !s.isCurrencySupported(to) {
-		return errors.New(errorToCurrencyNotSupported)
+		return errToCurrencyNotSupported
 	}
 
 	pair := from + to
 
 	// stub for rate with the same from/to currencies
 	if from == to {
-		res.Rate = s.toPrecise(1)
+		s.setRateDecimal(res, decimal.NewFromInt(1))
 		res.Pair = pair
 		res.Source = stubSource
 		res.CreatedAt = ptypes.TimestampNow()
+		res.EffectiveDate = res.CreatedAt
 		res.Volume = 1
+		s.setRateProvenance(res, collectionRatesNameSuffix, false)
 
 		return nil
 	}
 
+	return s.getCachedRate(collectionRatesNameSuffix, from, to, query, source, res)
+}
+
+func (s *Service) getDirectRate(collectionRatesNameSuffix string, pair string, query bson.M, source string, res *currencies.RateData) error {
 	query["pair"] = pair
+	s.setCurrentDateQuery(query)
 
 	isCentralbank := collectionRatesNameSuffix == currencies.RateTypeCentralbanks
 
@@ -272,14 +395,14 @@ func (s *Service) getRate(collectionRatesNameSuffix string, from string, to stri
 
 	if isCentralbank {
 		source = strings.ToUpper(source)
-		if _, ok := availableCentralbanksSources[source]; !ok {
+		if !s.isRateSourceOfType(currencies.RateTypeCentralbanks, source) {
 			// temporarily ignore unsupported central banks
 			zap.S().Warnw(errorSourceNotSupported, "source", source)
 		}
 		query["source"] = source
 	}
 
-	err = s.db.Collection(cName).Find(query).Sort("-_id").Limit(1).One(&res)
+	err = s.db.Collection(cName).Find(query).Sort("-effective_date", "-_id").Limit(1).One(&res)
 	if err != nil {
 		zap.L().Error(
 			pkg.ErrorDatabaseQueryFailed,
@@ -291,13 +414,15 @@ func (s *Service) getRate(collectionRatesNameSuffix string, from string, to stri
 
 	// requested pair is not found in central banks rates
 	// try to fallback to OXR rate for it
-	if err == mgo.ErrNotFound && isCentralbank {
+	fallback := err == mgo.ErrNotFound && isCentralbank
+	if fallback {
+		collectionRatesNameSuffix = collectionRatesNameSuffixOxr
 		cName, err = s.getCollectionName(collectionRatesNameSuffixOxr)
 		if err != nil {
 			return err
 		}
 		delete(query, "source")
-		err = s.db.Collection(cName).Find(query).Sort("-_id").Limit(1).One(&res)
+		err = s.db.Collection(cName).Find(query).Sort("-effective_date", "-_id").Limit(1).One(&res)
 		if err != nil {
 			zap.L().Error(
 				pkg.ErrorDatabaseQueryFailed,
@@ -312,27 +437,119 @@ func (s *Service) getRate(collectionRatesNameSuffix string, from string, to stri
 		return err
 	}
 
+	s.setRateProvenance(res, collectionRatesNameSuffix, fallback)
+
 	return nil
 }
 
+func (s *Service) copyQuery(query bson.M) bson.M {
+	res := make(bson.M, len(query))
+	for k, v := range query {
+		res[k] = v
+	}
+	return res
+}
+
 func (s *Service) saveRates(collectionRatesNameSuffix string, data []interface{}) error {
+	return s.insertRates(collectionRatesNameSuffix, data, false)
+}
+
+// saveRatesOnce - saves the rates with the ids assigned in advance, like the quarantined rates,
+// the rates saved already are skipped, so the save can be repeated after a partial failure
+func (s *Service) saveRatesOnce(collectionRatesNameSuffix string, data []interface{}) error {
+	return s.insertRates(collectionRatesNameSuffix, data, true)
+}
+
+func (s *Service) insertRates(collectionRatesNameSuffix string, data []interface{}, skipSaved bool) error {
 	cName, err := s.getCollectionName(collectionRatesNameSuffix)
 	if err != nil {
 		return err
 	}
 
-	err = s.db.Collection(cName).Insert(data...)
+	s.setRatesDefaultEffectiveDate(data)
+	s.setRatesDecimal(data)
+
+	if skipSaved {
+		// unordered insert doesn't stop on the saved rates, they are the only ones failed with duplicate key
+		bulk := s.db.Collection(cName).Bulk()
+		bulk.Unordered()
+		bulk.Insert(data...)
+
+		_, err = bulk.Run()
+		if mgo.IsDup(err) {
+			err = nil
+		}
+	} else {
+		err = s.db.Collection(cName).Insert(data...)
+	}
 
 	if err != nil {
 		zap.S().Errorw(errorDbInsertFailed, "error", err, "data", data)
 		return err
 	}
 
+	s.invalidateCache(s.ratesCache)
+	s.publishRatesUpdate(collectionRatesNameSuffix, data)
+
+	return nil
+}
+
+// setRatesEffectiveDate - stamps rates with the official date of the source, the date the rates are actual for
+func (s *Service) setRatesEffectiveDate(rates []interface{}, date time.Time) error {
+	ts, err := ptypes.TimestampProto(date)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range rates {
+		if rd, ok := item.(*currencies.RateData); ok {
+			rd.EffectiveDate = ts
+		}
+	}
+
 	return nil
 }
 
+// setRatesDefaultEffectiveDate - rates of sources without official date are actual since they are created
+func (s *Service) setRatesDefaultEffectiveDate(rates []interface{}) {
+	ts := ptypes.TimestampNow()
+
+	for _, item := range rates {
+		rd, ok := item.(*currencies.RateData)
+		if !ok || rd.EffectiveDate != nil {
+			continue
+		}
+
+		rd.EffectiveDate = ts
+		if rd.CreatedAt != nil {
+			rd.EffectiveDate = rd.CreatedAt
+		}
+	}
+}
+
+// setCurrentDateQuery - bounds the query of the current rate by now, if it's not bounded by date,
+// so the rates published in advance, like CBRF rates for tomorrow, aren't returned before their effective date
+func (s *Service) setCurrentDateQuery(query bson.M) {
+	if _, ok := query["effective_date"]; !ok {
+		query["effective_date"] = bson.M{"$lte": time.Now()}
+	}
+}
+
 func (s *Service) getByDateQuery(date time.Time) bson.M {
-	return bson.M{"created_at": bson.M{"$lte": now.New(date).EndOfDay()}}
+	return bson.M{"effective_date": bson.M{"$lte": now.New(date).EndOfDay()}}
+}
+
+// getSideQuery - adds the side of the exchange direction to the query of stock rates,
+// the stock rates saved before the sides were introduced have no side and match both directions
+func (s *Service) getSideQuery(rateType, exchangeDirection string, query bson.M) bson.M {
+	if rateType != currencies.RateTypeStock || exchangeDirection == "" {
+		return query
+	}
+
+	res := s.copyQuery(query)
+	res["side"] = bson.M{"$in": []interface{}{exchangeDirection, nil}}
+
+	return res
 }
 
 func (s *Service) exchangeCurrencyByDate(
@@ -341,12 +558,13 @@ func (s *Service) exchangeCurrencyByDate(
 	from string,
 	to string,
 	amount float64,
+	roundingMode string,
 	merchantId string,
 	date time.Time,
 	source string,
 	res *currencies.ExchangeCurrencyResponse,
 ) error {
-	return s.exchangeCurrency(rateType, exchangeDirection, from, to, amount, merchantId, s.getByDateQuery(date), source, res)
+	return s.exchangeCurrency(rateType, exchangeDirection, from, to, amount, roundingMode, merchantId, date, s.getByDateQuery(date), source, res)
 }
 
 func (s *Service) exchangeCurrency(
@@ -355,25 +573,49 @@ func (s *Service) exchangeCurrency(
 	from string,
 	to string,
 	amount float64,
+	roundingMode string,
 	merchantId string,
+	date time.Time,
 	query bson.M,
 	source string,
 	res *currencies.ExchangeCurrencyResponse,
 ) error {
 	rd := &currencies.RateData{}
-	err := s.getRate(rateType, from, to, query, source, rd)
+	err := s.getRate(rateType, from, to, s.getSideQuery(rateType, exchangeDirection, query), source, rd)
 	if err != nil {
 		return err
 	}
 
 	// ignore error possible here, it not change workflow,
 	// and a warning will be written to log in getCorrectionRule method body
-	rule, _ := s.getCorrectionRule(rateType, exchangeDirection, merchantId)
+	rule, _ := s.getCachedCorrectionRule(rateType, exchangeDirection, merchantId, date)
 	if rule == nil {
 		rule = &currencies.CorrectionRule{}
 	}
 
-	res.Correction = rule.GetCorrectionValue(rd.Pair)
+	s.exchangeAmount(rd, rule, exchangeDirection, to, amount, roundingMode, res)
+
+	zap.S().Infow("exchange currency", "from", from, "to", to, "amount", amount,
+		"rateType", rateType, "merchantId", merchantId, "query", query, "res", res)
+
+	return nil
+}
+
+// exchangeAmount - applies the correction rule to the rate and exchanges the amount by the corrected rate,
+// the rate is mutated
+func (s *Service) exchangeAmount(
+	rd *currencies.RateData,
+	rule *currencies.CorrectionRule,
+	exchangeDirection string,
+	to string,
+	amount float64,
+	roundingMode string,
+	res *currencies.ExchangeCurrencyResponse,
+) {
+	s.setCorrectionProvenance(rd, rule)
+
+	res.Correction = s.getCorrectionValue(rule, rd.Pair, amount)
+	res.CorrectionKind = s.getCorrectionRuleKind(rule)
 	res.ExchangeDirection = exchangeDirection
 
 	// applyCorrectionRule mutate rd object!
@@ -381,90 +623,121 @@ func (s *Service) exchangeCurrency(
 	// than apply correction rule
 	// and after that save corrected rate to response
 	res.OriginalRate = rd.Rate
-	s.applyCorrectionRule(rd, rule)
+	s.applyCorrectionRule(rd, rule, amount)
 	res.ExchangeRate = rd.Rate
 
-	res.ExchangedAmount = s.toPrecise(amount * res.ExchangeRate)
+	if roundingMode == "" {
+		roundingMode = s.getConfig().ExchangeRoundingMode
+	}
 
-	zap.S().Infow("exchange currency", "from", from, "to", to, "amount", amount,
-		"rateType", rateType, "merchantId", merchantId, "query", query, "res", res)
+	// exchanged amount is rounded to the precision of the target currency
+	rate := s.getRateDecimal(rd)
+	precision := s.getConfig().CurrenciesPrecision[to]
+	exchangedAmount := decimal.NewFromFloat(amount).Mul(rate).Round(precision, roundingMode)
 
-	return nil
+	res.ExchangeRateDecimal = rate.StringFixed(ratesPrecision)
+	res.ExchangedAmount = exchangedAmount.Float64()
+	res.ExchangedAmountDecimal = exchangedAmount.StringFixed(precision)
+	res.Provenance = rd.Provenance
 }
 
-func (s *Service) getCorrectionRule(rateType, exchangeDirection, merchantId string) (r *currencies.CorrectionRule, err error) {
+// getCorrectionRule - returns correction rule effective at passed time,
+// merchant's rule takes priority over the common one, then the rule with the latest start of validity is used
+func (s *Service) getCorrectionRule(
+	rateType, exchangeDirection, merchantId string,
+	date time.Time,
+) (r *currencies.CorrectionRule, err error) {
 
-	if !s.contains(s.cfg.RatesTypes, rateType) {
-		return nil, errors.New(errorRateTypeInvalid)
+	if !s.contains(s.getConfig().RatesTypes, rateType) {
+		return nil, errRateTypeInvalid
 	}
 
 	if !s.contains(pkg.SupportedExchangeDirections, exchangeDirection) {
-		return nil, errors.New(errorExchangeDirectionInvalid)
+		return nil, errExchangeDirectionInvalid
 	}
 
 	var sort []string
 	query := bson.M{
 		"rate_type":          rateType,
 		"exchange_direction": exchangeDirection,
+		"valid_from":         bson.M{"$lte": date},
+	}
+	conditions := []bson.M{
+		{"$or": []bson.M{{"valid_to": nil}, {"valid_to": bson.M{"$gt": date}}}},
 	}
 	if merchantId == "" {
 		query["merchant_id"] = ""
 	} else {
-		query["$or"] = []bson.M{{"merchant_id": merchantId}, {"merchant_id": ""}}
+		conditions = append(conditions, bson.M{"$or": []bson.M{{"merchant_id": merchantId}, {"merchant_id": ""}}})
 		sort = append(sort, "-merchant_id")
 	}
-	sort = append(sort, "-_id")
+	query["$and"] = conditions
+	sort = append(sort, "-valid_from", "-_id")
 
 	err = s.db.Collection(collectionNameCorrectionRules).Find(query).Sort(sort...).Limit(1).One(&r)
 
 	if err != nil {
-		zap.S().Warnw(errorCorrectionRuleNotFound, "error", err, "rateType", rateType, "exchangeDirection", exchangeDirection, "merchantId", merchantId)
+		zap.S().Warnw(errorCorrectionRuleNotFound, "error", err, "rateType", rateType, "exchangeDirection", exchangeDirection,
+			"merchantId", merchantId, "date", date)
 		return
 	}
 
 	return
 }
 
-func (s *Service) addCorrectionRule(
-	rateType string,
-	exchangeDirection string,
-	commonCorrection float64,
-	pairCorrection map[string]float64,
-	merchantId string,
-) error {
+// addCorrectionRule - validates passed rule and saves it as a new rule, the rules are never changed after that
+func (s *Service) addCorrectionRule(req *currencies.CorrectionRule) error {
 
-	if !s.contains(s.cfg.RatesTypes, rateType) {
-		return errors.New(errorRateTypeInvalid)
+	if !s.contains(s.getConfig().RatesTypes, req.RateType) {
+		return errRateTypeInvalid
 	}
 
-	if !s.contains(pkg.SupportedExchangeDirections, exchangeDirection) {
-		return errors.New(errorExchangeDirectionInvalid)
-	}
-
-	if !s.isCorrectionPercentValid(commonCorrection) {
-		return errors.New(errorCorrectionPercentInvalid)
+	if !s.contains(pkg.SupportedExchangeDirections, req.ExchangeDirection) {
+		return errExchangeDirectionInvalid
 	}
 
 	rule := &currencies.CorrectionRule{
 		Id:                bson.NewObjectId().Hex(),
 		CreatedAt:         ptypes.TimestampNow(),
-		RateType:          rateType,
-		ExchangeDirection: exchangeDirection,
-		CommonCorrection:  commonCorrection,
-		PairCorrection:    pairCorrection,
-		MerchantId:        merchantId,
-	}
-
-	if len(pairCorrection) > 0 {
-		for pair, val := range pairCorrection {
-			if !s.isPairExists(pair) {
-				zap.S().Errorw(errorCurrencyPairNotExists, "req", rule)
-				return errors.New(errorCurrencyPairNotExists)
-			}
-			if !s.isCorrectionPercentValid(val) {
-				return errors.New(errorCorrectionPercentInvalid)
-			}
+		RateType:          req.RateType,
+		ExchangeDirection: req.ExchangeDirection,
+		CommonCorrection:  req.CommonCorrection,
+		PairCorrection:    req.PairCorrection,
+		MerchantId:        req.MerchantId,
+		ValidFrom:         req.ValidFrom,
+		ValidTo:           req.ValidTo,
+		Kind:              req.Kind,
+		CommonSpread:      req.CommonSpread,
+		PairSpread:        req.PairSpread,
+		Tiers:             req.Tiers,
+	}
+
+	if rule.Kind == "" {
+		rule.Kind = correctionRuleKindPercent
+	}
+
+	if rule.ValidFrom == nil {
+		rule.ValidFrom = rule.CreatedAt
+	}
+
+	if rule.ValidTo != nil {
+		from, err := ptypes.Timestamp(rule.ValidFrom)
+		if err != nil {
+			return err
 		}
+		to, err := ptypes.Timestamp(rule.ValidTo)
+		if err != nil {
+			return err
+		}
+		if !to.After(from) {
+			zap.S().Errorw(errorCorrectionRuleValidity, "req", rule)
+			return errors.New(errorCorrectionRuleValidity)
+		}
+	}
+
+	if err := s.validateCorrectionRuleKind(rule); err != nil {
+		zap.S().Errorw(err.Error(), "req", rule)
+		return err
 	}
 
 	if err := s.validateReq(rule); err != nil {
@@ -478,11 +751,22 @@ func (s *Service) addCorrectionRule(
 		return err
 	}
 
+	s.invalidateCache(s.rulesCache)
+
 	return nil
 }
 
+// getCorrectionRuleDate - returns the time to get the correction rule for, the current time if it's not passed
+func (s *Service) getCorrectionRuleDate(ts *timestamp.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Now(), nil
+	}
+	return ptypes.Timestamp(ts)
+}
+
+// isCorrectionPercentValid - the sell rate is divided by (1 - percent / 100), so the correction must be less than 100%
 func (s *Service) isCorrectionPercentValid(val float64) bool {
-	return val >= 0 && val <= 100
+	return val >= 0 && val < 100
 }
 
 func (s *Service) sendCentrifugoMessage(message string, error error) {
@@ -498,26 +782,57 @@ func (s *Service) sendCentrifugoMessage(message string, error error) {
 		return
 	}
 
-	if err = s.centrifugoClient.Publish(context.Background(), s.cfg.CentrifugoChannel, b); err != nil {
+	if err = s.centrifugoClient.Publish(context.Background(), s.getConfig().CentrifugoChannel, b); err != nil {
 		zap.S().Errorw(errorCentrifugoSendMessage, "error", err, "message", message, "original_error", error)
 	}
 }
 
 func (s *Service) getCollectionName(suffix string) (string, error) {
-	if !s.contains(s.cfg.RatesTypes, suffix) {
-		return "", errors.New(errorRateTypeInvalid)
+	if !s.contains(s.getConfig().RatesTypes, suffix) {
+		return "", errRateTypeInvalid
 	}
 
 	return fmt.Sprintf(collectionRatesNameTemplate, collectionRatesNamePrefix, suffix), nil
 }
 
 func (s *Service) toPrecise(val float64) float64 {
-	p := math.Pow(10, ratesPrecision)
-	return math.Ceil(val*p) / p
+	return s.toPreciseDecimal(decimal.NewFromFloat(val)).Float64()
+}
+
+func (s *Service) toPreciseDecimal(val decimal.Decimal) decimal.Decimal {
+	return val.Round(ratesPrecision, ratesRoundingMode)
+}
+
+// getRateDecimal - returns rate as decimal, the decimal string stored with the rate takes priority over float value
+func (s *Service) getRateDecimal(rd *currencies.RateData) decimal.Decimal {
+	if rd.RateDecimal != "" {
+		if rate, err := decimal.NewFromString(rd.RateDecimal); err == nil {
+			return rate
+		}
+	}
+	return decimal.NewFromFloat(rd.Rate)
+}
+
+// setRateDecimal - sets rounded rate to both decimal and float fields of the rate
+func (s *Service) setRateDecimal(rd *currencies.RateData, rate decimal.Decimal) {
+	rate = s.toPreciseDecimal(rate)
+	rd.Rate = rate.Float64()
+	rd.RateDecimal = rate.StringFixed(ratesPrecision)
+}
+
+// setRatesDecimal - fills decimal representation of rates that calculated as floats
+func (s *Service) setRatesDecimal(rates []interface{}) {
+	for _, item := range rates {
+		rd, ok := item.(*currencies.RateData)
+		if !ok || rd.RateDecimal != "" {
+			continue
+		}
+		s.setRateDecimal(rd, decimal.NewFromFloat(rd.Rate))
+	}
 }
 
-func (s *Service) applyCorrection(rd *currencies.RateData, rateType, exchangeDirection, merchantId string) {
-	rule, err := s.getCorrectionRule(rateType, exchangeDirection, merchantId)
+func (s *Service) applyCorrection(rd *currencies.RateData, rateType, exchangeDirection, merchantId string, date time.Time) {
+	rule, err := s.getCachedCorrectionRule(rateType, exchangeDirection, merchantId, date)
 	if err != nil {
 		// here is simple return, no error report need
 		return
@@ -526,25 +841,40 @@ func (s *Service) applyCorrection(rd *currencies.RateData, rateType, exchangeDir
 		rule = &currencies.CorrectionRule{}
 	}
 
-	s.applyCorrectionRule(rd, rule)
+	s.setCorrectionProvenance(rd, rule)
+
+	// the rates are requested without amount, so the first tier of tiered rule is applied
+	s.applyCorrectionRule(rd, rule, 0)
 }
 
-func (s *Service) applyCorrectionRule(rd *currencies.RateData, rule *currencies.CorrectionRule) {
-	value := rule.GetCorrectionValue(rd.Pair)
+func (s *Service) applyCorrectionRule(rd *currencies.RateData, rule *currencies.CorrectionRule, amount float64) {
+	value := s.getCorrectionValue(rule, rd.Pair, amount)
 	if value == 0 {
 		return
 	}
 
-	divider := float64(1)
+	if rule.Kind == correctionRuleKindFixedSpread {
+		s.applyCorrectionSpread(rd, rule.ExchangeDirection, value)
+		return
+	}
+
+	divider := decimal.NewFromInt(1)
+	percent := decimal.NewFromFloat(value).Div(decimal.NewFromInt(100))
 
 	switch rule.ExchangeDirection {
 
 	case currencies.ExchangeDirectionSell:
-		divider = 1 - (value / 100)
+		divider = divider.Sub(percent)
 
 	case currencies.ExchangeDirectionBuy:
-		divider = 1 + (value / 100)
+		divider = divider.Add(percent)
+	}
+
+	// the rules stored before the correction was limited by 100% are not applied instead of zeroing the rate
+	if divider.Cmp(decimal.Zero()) <= 0 {
+		zap.S().Errorw(errorCorrectionPercentInvalid, "ruleId", rule.Id, "pair", rd.Pair, "correction", value)
+		return
 	}
 
-	rd.Rate = s.toPrecise(rd.Rate / divider)
+	s.setRateDecimal(rd, s.getRateDecimal(rd).Div(divider))
 }
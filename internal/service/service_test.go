@@ -8,10 +8,12 @@ import (
 	"github.com/golang-migrate/migrate/v4"
 	_ "github.com/golang-migrate/migrate/v4/database/mongodb"
 	_ "github.com/golang-migrate/migrate/v4/source/file"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/jinzhu/now"
 	"github.com/paysuper/paysuper-currencies/config"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/paysuper/paysuper-database-mongo"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/zap"
@@ -56,6 +58,10 @@ func (suite *CurrenciesratesServiceTestSuite) SetupTest() {
 	db, err := database.NewDatabase()
 	assert.NoError(suite.T(), err, "Db connection failed")
 
+	// test sources are registered for each test and removed after it, so they don't leak into the global registry
+	RegisterRateSource(newBackfillTestSource())
+	RegisterRateSource(newSchedulerTestSource())
+
 	suite.service, err = NewService(suite.config, db)
 	assert.NoError(suite.T(), err, "Service creation failed")
 
@@ -80,6 +86,9 @@ func (suite *CurrenciesratesServiceTestSuite) SetupTest() {
 }
 
 func (suite *CurrenciesratesServiceTestSuite) TearDownTest() {
+	delete(rateSources, backfillTestSource)
+	delete(rateSources, schedulerTestSource)
+
 	if err := suite.service.db.Drop(); err != nil {
 		suite.FailNow("Database deletion failed", "%v", err)
 	}
@@ -111,8 +120,8 @@ func (suite *CurrenciesratesServiceTestSuite) CleanRatesCollection(collectionSuf
 }
 
 func (suite *CurrenciesratesServiceTestSuite) TestService_CreatedOk() {
-	assert.True(suite.T(), len(suite.service.cfg.RatesRequestCurrencies) > 0)
-	assert.True(suite.T(), len(suite.service.cfg.SettlementCurrencies) > 0)
+	assert.True(suite.T(), len(suite.service.getConfig().RatesRequestCurrencies) > 0)
+	assert.True(suite.T(), len(suite.service.getConfig().SettlementCurrencies) > 0)
 }
 
 func (suite *CurrenciesratesServiceTestSuite) TestIsCurrencySupported_Ok() {
@@ -202,7 +211,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	}
 
 	// no correction rule set, rate unchanged
-	suite.service.applyCorrection(rd, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId)
+	suite.service.applyCorrection(rd, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
 	assert.Equal(suite.T(), rd.Rate, float64(0.89))
 
 	// adding default correction rule for Sell
@@ -216,7 +225,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	assert.NoError(suite.T(), err)
 
 	// rate for Buy will be still unchanged
-	suite.service.applyCorrection(rd, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId)
+	suite.service.applyCorrection(rd, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
 	assert.Equal(suite.T(), rd.Rate, float64(0.89))
 
 	rd2 := &currencies.RateData{
@@ -226,7 +235,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	}
 
 	// rate for sell increased for 1%
-	suite.service.applyCorrection(rd2, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId)
+	suite.service.applyCorrection(rd2, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId, time.Now())
 	assert.Equal(suite.T(), rd2.Rate, suite.service.toPrecise(float64(0.89)/(1-(float64(1)/100))))
 	assert.Equal(suite.T(), rd2.Rate, float64(0.89899))
 
@@ -263,7 +272,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	}
 
 	// rate for Buy decreased for 3% by pair rule for merchant
-	suite.service.applyCorrection(rd3, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId)
+	suite.service.applyCorrection(rd3, currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, merchantId, time.Now())
 	assert.Equal(suite.T(), req2.GetCorrectionValue("USDEUR"), float64(3))
 	assert.Equal(suite.T(), req2.GetCorrectionValue(rd3.Pair), float64(3))
 	assert.Equal(suite.T(), rd3.Rate, suite.service.toPrecise(float64(0.89)/(1+(float64(3)/100))))
@@ -276,7 +285,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	}
 
 	// rate for Sell increased for 3% by pair rule for merchant
-	suite.service.applyCorrection(rd4, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId)
+	suite.service.applyCorrection(rd4, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId, time.Now())
 	assert.Equal(suite.T(), req2.GetCorrectionValue("USDEUR"), float64(3))
 	assert.Equal(suite.T(), req2.GetCorrectionValue(rd4.Pair), float64(3))
 	assert.Equal(suite.T(), rd4.Rate, suite.service.toPrecise(float64(0.89)/(1-(float64(3)/100))))
@@ -289,7 +298,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestApplyCorrection() {
 	}
 
 	// rate increased for 5% by common rule for merchant
-	suite.service.applyCorrection(rd5, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId)
+	suite.service.applyCorrection(rd5, currencies.RateTypeOxr, currencies.ExchangeDirectionSell, merchantId, time.Now())
 	assert.Equal(suite.T(), req2.GetCorrectionValue("RUBUSD"), float64(5))
 	assert.Equal(suite.T(), rd5.Rate, suite.service.toPrecise(float64(0.89)/(1-(float64(5)/100))))
 	assert.Equal(suite.T(), rd5.Rate, float64(0.936843))
@@ -313,54 +322,68 @@ func (suite *CurrenciesratesServiceTestSuite) Test_getCollectionName_Fail() {
 
 func (suite *CurrenciesratesServiceTestSuite) Test_addRateCorrectionRule_Ok() {
 
-	err := suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 0, map[string]float64{}, "")
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy})
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 0, map[string]float64{}, bson.NewObjectId().Hex())
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, MerchantId: bson.NewObjectId().Hex()})
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 1, map[string]float64{}, bson.NewObjectId().Hex())
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1, MerchantId: bson.NewObjectId().Hex()})
 	assert.NoError(suite.T(), err)
 
 	pairCorrection := map[string]float64{
 		"USDEUR": 3,
 		"EURUSD": 3,
 	}
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 1, pairCorrection, bson.NewObjectId().Hex())
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1, PairCorrection: pairCorrection, MerchantId: bson.NewObjectId().Hex()})
 	assert.NoError(suite.T(), err)
 
 	pairCorrection = map[string]float64{
 		"USDEUR": 3,
 		"EURUSD": 3,
 	}
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 0, pairCorrection, "")
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, PairCorrection: pairCorrection})
 	assert.NoError(suite.T(), err)
 }
 
+func (suite *CurrenciesratesServiceTestSuite) Test_applyCorrectionRule_FullSellCorrection() {
+	rd := &currencies.RateData{Pair: "USDRUB", Rate: r}
+	rule := &currencies.CorrectionRule{ExchangeDirection: currencies.ExchangeDirectionSell, CommonCorrection: 100}
+
+	// the rule stored before the limit is not applied, the rate isn't zeroed
+	suite.service.applyCorrectionRule(rd, rule, 0)
+	assert.Equal(suite.T(), rd.Rate, r)
+}
+
 func (suite *CurrenciesratesServiceTestSuite) Test_addRateCorrectionRule_Fail() {
 
-	err := suite.service.addCorrectionRule("", currencies.ExchangeDirectionBuy, 0, map[string]float64{}, "")
+	err := suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: "", ExchangeDirection: currencies.ExchangeDirectionBuy})
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorRateTypeInvalid)
 
-	err = suite.service.addCorrectionRule("bla-bla-bla", currencies.ExchangeDirectionBuy, 0, map[string]float64{}, "")
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: "bla-bla-bla", ExchangeDirection: currencies.ExchangeDirectionBuy})
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorRateTypeInvalid)
 
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 101, map[string]float64{}, "")
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 101})
 	assert.Error(suite.T(), err)
 
+	// the sell rate would be divided by zero
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionSell, CommonCorrection: 100})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionPercentInvalid)
+
 	pairCorrection := map[string]float64{
 		"USDEUR": 101,
 	}
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 0, pairCorrection, "")
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, PairCorrection: pairCorrection})
 	assert.Error(suite.T(), err)
 
 	pairCorrection = map[string]float64{
 		"USDEUR": 3,
 		"EURZWD": 3,
 	}
-	err = suite.service.addCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, 0, pairCorrection, "")
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, PairCorrection: pairCorrection})
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorCurrencyPairNotExists)
 }
@@ -389,7 +412,7 @@ func (suite *CurrenciesratesServiceTestSuite) Test_validateUrl_Fail() {
 func (suite *CurrenciesratesServiceTestSuite) Test_getByDateQuery_Ok() {
 	date := time.Now()
 	query := suite.service.getByDateQuery(date)
-	assert.Equal(suite.T(), query["created_at"], bson.M{"$lte": now.New(date).EndOfDay()})
+	assert.Equal(suite.T(), query["effective_date"], bson.M{"$lte": now.New(date).EndOfDay()})
 }
 
 func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrency_Ok() {
@@ -397,7 +420,7 @@ func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrency_Ok() {
 	res := &currencies.ExchangeCurrencyResponse{}
 
 	// requesting exchange
-	err := suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 100, merchantId, bson.M{}, "", res)
+	err := suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 100, "", merchantId, time.Now(), bson.M{}, "", res)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), res.ExchangedAmount, float64(6463.14))
 	assert.Equal(suite.T(), res.ExchangeRate, float64(64.6314))
@@ -408,19 +431,19 @@ func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrency_Ok() {
 func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrency_Fail() {
 	res := &currencies.ExchangeCurrencyResponse{}
 
-	err := suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "BLA", "USD", 100, "", bson.M{}, "", res)
+	err := suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "BLA", "USD", 100, "", "", time.Now(), bson.M{}, "", res)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorFromCurrencyNotSupported)
 
-	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "", 100, "", bson.M{}, "", res)
+	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "", 100, "", "", time.Now(), bson.M{}, "", res)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorToCurrencyNotSupported)
 
-	err = suite.service.exchangeCurrency("bla-bla", currencies.ExchangeDirectionBuy, "USD", "RUB", 100, "", bson.M{}, "", res)
+	err = suite.service.exchangeCurrency("bla-bla", currencies.ExchangeDirectionBuy, "USD", "RUB", 100, "", "", time.Now(), bson.M{}, "", res)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), errorRateTypeInvalid)
 
-	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "EUR", 100, "", bson.M{}, "", res)
+	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "EUR", 100, "", "", time.Now(), bson.M{}, "", res)
 	assert.Error(suite.T(), err)
 	assert.Equal(suite.T(), err.Error(), mgo.ErrNotFound.Error())
 }
@@ -430,10 +453,175 @@ func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrencyByDate_Ok() {
 	res := &currencies.ExchangeCurrencyResponse{}
 
 	// requesting exchange
-	err := suite.service.exchangeCurrencyByDate(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 100, merchantId, time.Now(), "", res)
+	err := suite.service.exchangeCurrencyByDate(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 100, "", merchantId, time.Now(), "", res)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), res.ExchangedAmount, float64(6463.14))
 	assert.Equal(suite.T(), res.ExchangeRate, float64(64.6314))
 	assert.Equal(suite.T(), res.Correction, float64(0))
 	assert.Equal(suite.T(), res.OriginalRate, float64(64.6314))
 }
+
+func (suite *CurrenciesratesServiceTestSuite) Test_getRateByDate_EffectiveDate() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+
+	// friday rate that has been fetched on monday
+	friday := now.New(time.Now().AddDate(0, 0, -10)).BeginningOfDay()
+	rates := []interface{}{
+		&currencies.RateData{
+			Pair:   "USDRUB",
+			Rate:   r,
+			Source: cbrfSource,
+			Volume: 1,
+		},
+	}
+	err = suite.service.setRatesEffectiveDate(rates, friday)
+	assert.NoError(suite.T(), err)
+	err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	assert.NoError(suite.T(), err)
+
+	res := &currencies.RateData{}
+	err = suite.service.getRateByDate(currencies.RateTypeCentralbanks, "USD", "RUB", friday, cbrfSource, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Rate, r)
+
+	err = suite.service.getRateByDate(currencies.RateTypeCentralbanks, "USD", "RUB", friday.AddDate(0, 0, -1), cbrfSource, res)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_getRate_PublishedInAdvance() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
+	assert.NoError(suite.T(), err)
+
+	// cbrf publishes the rate for tomorrow today
+	today := now.BeginningOfDay()
+	for i, rate := range []float64{r, r + 1} {
+		rates := []interface{}{
+			&currencies.RateData{
+				Pair:   "USDRUB",
+				Rate:   rate,
+				Source: cbrfSource,
+				Volume: 1,
+			},
+		}
+		err = suite.service.setRatesEffectiveDate(rates, today.AddDate(0, 0, i))
+		assert.NoError(suite.T(), err)
+		err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+		assert.NoError(suite.T(), err)
+	}
+
+	res := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeCentralbanks, "USD", "RUB", bson.M{}, cbrfSource, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Rate, r)
+
+	res = &currencies.RateData{}
+	err = suite.service.getRateByDate(currencies.RateTypeCentralbanks, "USD", "RUB", today.AddDate(0, 0, 1), cbrfSource, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Rate, r+1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_setRatesDefaultEffectiveDate() {
+	createdAt, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -1))
+	assert.NoError(suite.T(), err)
+	effectiveDate, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -2))
+	assert.NoError(suite.T(), err)
+
+	rates := []interface{}{
+		&currencies.RateData{Pair: "USDRUB"},
+		&currencies.RateData{Pair: "USDEUR", CreatedAt: createdAt},
+		&currencies.RateData{Pair: "USDPLN", CreatedAt: createdAt, EffectiveDate: effectiveDate},
+	}
+	suite.service.setRatesDefaultEffectiveDate(rates)
+
+	assert.NotNil(suite.T(), rates[0].(*currencies.RateData).EffectiveDate)
+	assert.Equal(suite.T(), rates[1].(*currencies.RateData).EffectiveDate, createdAt)
+	assert.Equal(suite.T(), rates[2].(*currencies.RateData).EffectiveDate, effectiveDate)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_exchangeCurrency_RoundingMode() {
+	res := &currencies.ExchangeCurrencyResponse{}
+
+	// 0.03 * 64.6314 = 1.938942
+	err := suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 0.03, decimal.RoundFloor, "", time.Now(), bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangedAmount, float64(1.93))
+	assert.Equal(suite.T(), res.ExchangedAmountDecimal, "1.93")
+	assert.Equal(suite.T(), res.ExchangeRateDecimal, "64.631400")
+
+	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 0.03, decimal.RoundCeil, "", time.Now(), bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangedAmount, float64(1.94))
+
+	// service default rounding mode is half-even
+	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 0.03, "", "", time.Now(), bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.ExchangedAmount, float64(1.94))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_saveRates_RateDecimal() {
+	rd := &currencies.RateData{
+		Pair:   "USDRUB",
+		Rate:   r,
+		Source: "TEST",
+		Volume: 1,
+	}
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, []interface{}{rd})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.RateDecimal, "64.631400")
+
+	res := &currencies.RateData{}
+	err = suite.service.getRate(currencies.RateTypeOxr, "USD", "RUB", bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.RateDecimal, "64.631400")
+	assert.Equal(suite.T(), suite.service.getRateDecimal(res).Float64(), r)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_getCorrectionRule_ValidityPeriod() {
+	past, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -10))
+	assert.NoError(suite.T(), err)
+	pastEnd, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -5))
+	assert.NoError(suite.T(), err)
+	nextMonth, err := ptypes.TimestampProto(now.BeginningOfMonth().AddDate(0, 1, 0))
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 3, ValidFrom: past, ValidTo: pastEnd})
+	assert.NoError(suite.T(), err)
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1})
+	assert.NoError(suite.T(), err)
+	// scheduled fee change
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 5, ValidFrom: nextMonth})
+	assert.NoError(suite.T(), err)
+
+	rule, err := suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(1))
+
+	rule, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now().AddDate(0, 0, -7))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(3))
+
+	rule, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", now.BeginningOfMonth().AddDate(0, 1, 1))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rule.CommonCorrection, float64(5))
+
+	_, err = suite.service.getCorrectionRule(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "", time.Now().AddDate(0, 0, -20))
+	assert.Error(suite.T(), err)
+
+	// historical exchange uses the rule effective at the requested date
+	res := &currencies.ExchangeCurrencyResponse{}
+	err = suite.service.exchangeCurrency(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, "USD", "RUB", 100, "", "", time.Now().AddDate(0, 0, -7), bson.M{}, "", res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Correction, float64(3))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) Test_addCorrectionRule_ValidityPeriodFail() {
+	from, err := ptypes.TimestampProto(time.Now())
+	assert.NoError(suite.T(), err)
+	to, err := ptypes.TimestampProto(time.Now().AddDate(0, 0, -1))
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.addCorrectionRule(&currencies.CorrectionRule{RateType: currencies.RateTypeOxr, ExchangeDirection: currencies.ExchangeDirectionBuy, CommonCorrection: 1, ValidFrom: from, ValidTo: to})
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorCorrectionRuleValidity)
+}
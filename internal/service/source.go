@@ -0,0 +1,281 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	errorRateSourceDuplicated = "rates source already registered"
+	errorRateSourceNotFound   = "rates source not found"
+	errorRatesSaveFailed      = "Rates save failed"
+	errorRatesConfirmFailed   = "Rates save confirmation failed"
+)
+
+// RateSource - plugin to retrieve rates of one type from a single provider
+type RateSource interface {
+	// Name - code of the source, it's saved to the source field of each rate
+	Name() string
+	// RateType - rate type of the source, also it's the suffix of collection to store rates to
+	RateType() string
+	// BaseCurrency - home currency of the source, empty for sources without single home currency
+	BaseCurrency() string
+	// Fetch - requests, parses and processes rates, returns rates ready to be saved
+	Fetch(s *Service) ([]interface{}, error)
+}
+
+// HistoricalRateSource - rates source plugin that also able to retrieve rates for the past dates
+type HistoricalRateSource interface {
+	RateSource
+	// FetchHistory - requests rates for the dates range, each rate is stamped with its official date
+	FetchHistory(s *Service, from, to time.Time) ([]interface{}, error)
+}
+
+// ConfirmedRateSource - rates source plugin that must be notified when the fetched rates are saved,
+// e.g. to mark the imported data as consumed only after the rates are stored
+type ConfirmedRateSource interface {
+	RateSource
+	// FetchConfirmed - same as Fetch, also returns the reference to the consumed data, like the path of the imported file.
+	// The reference is passed to Confirm, so the concurrent requests of the source don't confirm each other's data
+	FetchConfirmed(s *Service) ([]interface{}, string, error)
+	// Confirm - called with the reference returned by FetchConfirmed after its rates are saved or quarantined
+	Confirm(s *Service, ref string) error
+}
+
+type rateSource struct {
+	name         string
+	rateType     string
+	baseCurrency string
+	fetch        func(s *Service) ([]interface{}, error)
+}
+
+var (
+	rateSources = map[string]RateSource{}
+
+	// rate types that calculated from rates of other type, they must be recalculated after the base type updated
+	derivedRateTypes = map[string][]string{
+		currencies.RateTypeOxr: {currencies.RateTypePaysuper, currencies.RateTypeStock},
+	}
+)
+
+// NewRateSource - returns rates source plugin built from passed fetch function
+func NewRateSource(name, rateType, baseCurrency string, fetch func(s *Service) ([]interface{}, error)) RateSource {
+	return &rateSource{
+		name:         name,
+		rateType:     rateType,
+		baseCurrency: baseCurrency,
+		fetch:        fetch,
+	}
+}
+
+type historicalRateSource struct {
+	rateSource
+	fetchHistory func(s *Service, from, to time.Time) ([]interface{}, error)
+}
+
+// NewHistoricalRateSource - returns rates source plugin built from passed fetch functions for current and past rates
+func NewHistoricalRateSource(
+	name, rateType, baseCurrency string,
+	fetch func(s *Service) ([]interface{}, error),
+	fetchHistory func(s *Service, from, to time.Time) ([]interface{}, error),
+) RateSource {
+	return &historicalRateSource{
+		rateSource: rateSource{
+			name:         name,
+			rateType:     rateType,
+			baseCurrency: baseCurrency,
+			fetch:        fetch,
+		},
+		fetchHistory: fetchHistory,
+	}
+}
+
+func (r *historicalRateSource) FetchHistory(s *Service, from, to time.Time) ([]interface{}, error) {
+	return r.fetchHistory(s, from, to)
+}
+
+func (r *rateSource) Name() string {
+	return r.name
+}
+
+func (r *rateSource) RateType() string {
+	return r.rateType
+}
+
+func (r *rateSource) BaseCurrency() string {
+	return r.baseCurrency
+}
+
+func (r *rateSource) Fetch(s *Service) ([]interface{}, error) {
+	return r.fetch(s)
+}
+
+// RegisterRateSource - adds rates source to registry, must be called from init() of the source
+func RegisterRateSource(src RateSource) {
+	name := strings.ToUpper(src.Name())
+	if _, ok := rateSources[name]; ok {
+		panic(fmt.Sprintf("%s: %s", errorRateSourceDuplicated, name))
+	}
+	rateSources[name] = src
+}
+
+// GetRateSource - returns registered rates source by its name
+func GetRateSource(name string) (RateSource, bool) {
+	src, ok := rateSources[strings.ToUpper(name)]
+	return src, ok
+}
+
+// GetRateSourcesByType - returns registered rates sources of passed rate type, sorted by name
+func GetRateSourcesByType(rateType string) []RateSource {
+	var sources []RateSource
+	for _, src := range rateSources {
+		if src.RateType() == rateType {
+			sources = append(sources, src)
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name() < sources[j].Name()
+	})
+
+	return sources
+}
+
+// GetRateSourcesNames - returns names of all registered rates sources of passed rate type, sorted by name
+func GetRateSourcesNames(rateType string) []string {
+	var names []string
+	for _, src := range GetRateSourcesByType(rateType) {
+		names = append(names, src.Name())
+	}
+	return names
+}
+
+// RequestRates - retrieving rates from passed source and saving them
+func (s *Service) RequestRates(name string) error {
+	src, ok := GetRateSource(name)
+	if !ok {
+		zap.S().Errorw(errorRateSourceNotFound, "source", name)
+		return errors.New(errorRateSourceNotFound)
+	}
+
+	zap.S().Infow("Requesting rates", "source", src.Name())
+
+	var (
+		rates []interface{}
+		ref   string
+		err   error
+	)
+
+	if cs, ok := src.(ConfirmedRateSource); ok {
+		rates, ref, err = cs.FetchConfirmed(s)
+	} else {
+		rates, err = src.Fetch(s)
+	}
+	if err != nil {
+		return err
+	}
+
+	rates, err = s.checkRatesAnomalies(src, rates, time.Now())
+	if err != nil {
+		// quarantined rates are kept in the batch till approve, so the source must not return them again
+		if err == errRatesQuarantined {
+			if e := s.confirmRates(src, ref); e != nil {
+				return e
+			}
+		}
+		return err
+	}
+
+	err = s.saveRates(src.RateType(), rates)
+	if err != nil {
+		zap.S().Errorw(errorRatesSaveFailed, "error", err, "source", src.Name())
+		s.sendCentrifugoMessage(errorRatesSaveFailed, err)
+		return err
+	}
+
+	if err = s.confirmRates(src, ref); err != nil {
+		return err
+	}
+
+	zap.S().Infow("Rates updated", "source", src.Name())
+
+	return nil
+}
+
+// confirmRates - notifies the confirmed rates source that the rates fetched with the reference are consumed
+func (s *Service) confirmRates(src RateSource, ref string) error {
+	cs, ok := src.(ConfirmedRateSource)
+	if !ok {
+		return nil
+	}
+
+	if err := cs.Confirm(s, ref); err != nil {
+		zap.S().Errorw(errorRatesConfirmFailed, "error", err, "source", src.Name(), "ref", ref)
+		s.sendCentrifugoMessage(errorRatesConfirmFailed, err)
+		return err
+	}
+
+	return nil
+}
+
+// RequestRatesByType - retrieving rates from all sources of passed rate type
+// and recalculation of rate types derived from it
+func (s *Service) RequestRatesByType(rateType string) error {
+	sources := GetRateSourcesByType(rateType)
+	if len(sources) == 0 {
+		zap.S().Errorw(errorRateSourceNotFound, "rateType", rateType)
+		return errors.New(errorRateSourceNotFound)
+	}
+
+	g := errgroup.Group{}
+	for _, src := range sources {
+		name := src.Name()
+		g.Go(func() error {
+			return s.RequestRates(name)
+		})
+	}
+
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	g = errgroup.Group{}
+	for _, derived := range derivedRateTypes[rateType] {
+		rt := derived
+		g.Go(func() error {
+			return s.RequestRatesByType(rt)
+		})
+	}
+
+	return g.Wait()
+}
+
+// RequestRatesBySourceOrType - retrieving rates for passed rate type or, if there is no such type, for passed source name
+func (s *Service) RequestRatesBySourceOrType(name string) error {
+	if len(GetRateSourcesByType(name)) > 0 {
+		return s.RequestRatesByType(name)
+	}
+	return s.RequestRates(name)
+}
+
+func isDerivedRateType(rateType string) bool {
+	for _, types := range derivedRateTypes {
+		for _, rt := range types {
+			if rt == rateType {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *Service) isRateSourceOfType(rateType, name string) bool {
+	src, ok := GetRateSource(name)
+	return ok && src.RateType() == rateType
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"github.com/ProtocolONE/rabbitmq/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+	"time"
+)
+
+const (
+	errorCardpayBrokerInitFailed  = "Cardpay rates broker init failed"
+	errorCardpaySubscribeFailed   = "Cardpay rates subscribe failed"
+	errorCardpayRateInvalid       = "Cardpay rate data is invalid"
+	errorCardpayProcessRateFailed = "Cardpay rate processing failed"
+	errorCardpayRetryFailed       = "Cardpay rate send to retry queue failed"
+	errorCardpayFinishFailed      = "Cardpay rate send to finish queue failed"
+
+	cardpaySource = "CARDPAY"
+
+	cardpayTopicRates       = "cardpay_rates"
+	cardpayTopicRatesRetry  = "cardpay_rates_retry"
+	cardpayTopicRatesFinish = "cardpay_rates_finish"
+
+	cardpayRetryCountHeader = "x-retry-count"
+	cardpayRetryMaxCount    = 5
+	cardpayRetryDlxTimeout  = 600
+)
+
+// initCardpayBrokers - subscribes to the pushed Cardpay rates. Cardpay is not registered as RateSource,
+// because there is nothing to fetch from it by the scheduler or by the -source flag
+func (s *Service) initCardpayBrokers() error {
+	var err error
+
+	s.cardpayBroker, err = rabbitmq.NewBroker(s.getConfig().BrokerAddress)
+	if err != nil {
+		zap.S().Errorw(errorCardpayBrokerInitFailed, "error", err, "topic", cardpayTopicRates)
+		return err
+	}
+
+	s.cardpayRetryBroker, err = rabbitmq.NewBroker(s.getConfig().BrokerAddress)
+	if err != nil {
+		zap.S().Errorw(errorCardpayBrokerInitFailed, "error", err, "topic", cardpayTopicRatesRetry)
+		return err
+	}
+
+	// messages from retry queue returns to the main exchange after ttl is expired
+	s.cardpayRetryBroker.Opts.ExchangeOpts.Name = cardpayTopicRatesRetry
+	s.cardpayRetryBroker.Opts.QueueOpts.Args = amqp.Table{
+		"x-dead-letter-exchange":    cardpayTopicRates,
+		"x-message-ttl":             int32(cardpayRetryDlxTimeout * 1000),
+		"x-dead-letter-routing-key": "*",
+	}
+
+	s.cardpayFinishBroker, err = rabbitmq.NewBroker(s.getConfig().BrokerAddress)
+	if err != nil {
+		zap.S().Errorw(errorCardpayBrokerInitFailed, "error", err, "topic", cardpayTopicRatesFinish)
+		return err
+	}
+	s.cardpayFinishBroker.Opts.ExchangeOpts.Name = cardpayTopicRatesFinish
+
+	s.cardpayBroker.Opts.ExchangeOpts.Name = cardpayTopicRates
+	err = s.cardpayBroker.RegisterSubscriber(cardpayTopicRates, s.processCardpayRate)
+	if err != nil {
+		zap.S().Errorw(errorCardpayBrokerInitFailed, "error", err, "topic", cardpayTopicRates)
+		return err
+	}
+
+	go func() {
+		if err := s.cardpayBroker.Subscribe(nil); err != nil {
+			zap.S().Errorw(errorCardpaySubscribeFailed, "error", err)
+			s.sendCentrifugoMessage(errorCardpaySubscribeFailed, err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *Service) processCardpayRate(msg *currencies.CardpayRate, d amqp.Delivery) error {
+	rates, err := s.processRatesCardpay(msg)
+	if err != nil {
+		// invalid data will not become valid after retry, so it goes directly to finish queue
+		zap.S().Errorw(errorCardpayRateInvalid, "error", err, "msg", msg)
+		return s.finishCardpayRate(msg, err)
+	}
+
+	rates, err = s.checkRatesAnomalies(s.newCardpayRateSource(msg), rates, time.Now())
+	if err != nil {
+		// quarantined rate is saved on approve, so it's not retried
+		if err == errRatesQuarantined {
+			return nil
+		}
+		zap.S().Errorw(errorCardpayProcessRateFailed, "error", err, "msg", msg)
+		return s.retryCardpayRate(msg, d, err)
+	}
+
+	if len(rates) == 0 {
+		return nil
+	}
+
+	err = s.saveRates(collectionRatesNameSuffixCardpay, rates)
+	if err != nil {
+		zap.S().Errorw(errorCardpayProcessRateFailed, "error", err, "msg", msg)
+		return s.retryCardpayRate(msg, d, err)
+	}
+
+	return nil
+}
+
+// newCardpayRateSource - returns not registered source of the pushed rate, it's used for anomalies check only
+func (s *Service) newCardpayRateSource(msg *currencies.CardpayRate) RateSource {
+	source := msg.Source
+	if source == "" {
+		source = cardpaySource
+	}
+
+	return NewRateSource(source, currencies.RateTypeCardpay, "", func(s *Service) ([]interface{}, error) {
+		return nil, nil
+	})
+}
+
+func (s *Service) processRatesCardpay(msg *currencies.CardpayRate) ([]interface{}, error) {
+	if err := s.validateReq(msg); err != nil {
+		return nil, err
+	}
+
+	if !s.isCurrencySupported(msg.From) {
+		return nil, errFromCurrencyNotSupported
+	}
+
+	if !s.isCurrencySupported(msg.To) {
+		return nil, errToCurrencyNotSupported
+	}
+
+	source := msg.Source
+	if source == "" {
+		source = cardpaySource
+	}
+
+	volume := msg.Volume
+	if volume == 0 {
+		volume = 1
+	}
+
+	rates := []interface{}{
+		&currencies.RateData{
+			Pair:      msg.From + msg.To,
+			Rate:      s.toPrecise(msg.Rate),
+			Source:    source,
+			Volume:    volume,
+			CreatedAt: msg.CreatedAt,
+		},
+	}
+
+	return rates, nil
+}
+
+func (s *Service) retryCardpayRate(msg *currencies.CardpayRate, d amqp.Delivery, cause error) error {
+	retryCount := int32(0)
+	if v, ok := d.Headers[cardpayRetryCountHeader]; ok {
+		retryCount, _ = v.(int32)
+	}
+
+	if retryCount >= cardpayRetryMaxCount {
+		return s.finishCardpayRate(msg, cause)
+	}
+
+	err := s.cardpayRetryBroker.Publish(cardpayTopicRatesRetry, msg, amqp.Table{cardpayRetryCountHeader: retryCount + 1})
+	if err != nil {
+		zap.S().Errorw(errorCardpayRetryFailed, "error", err, "msg", msg)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) finishCardpayRate(msg *currencies.CardpayRate, cause error) error {
+	s.sendCentrifugoMessage(errorCardpayProcessRateFailed, cause)
+
+	err := s.cardpayFinishBroker.Publish(cardpayTopicRatesFinish, msg, amqp.Table{})
+	if err != nil {
+		zap.S().Errorw(errorCardpayFinishFailed, "error", err, "msg", msg)
+		return err
+	}
+
+	return nil
+}
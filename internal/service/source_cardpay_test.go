@@ -0,0 +1,86 @@
+package service
+
+import (
+	"context"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestSourceCardpay_ProcessRatesFailed() {
+	msg := &currencies.CardpayRate{
+		CreatedAt: ptypes.TimestampNow(),
+		From:      "USD",
+		To:        "RUB",
+		Source:    cardpaySource,
+	}
+	_, err := suite.service.processRatesCardpay(msg)
+	assert.Error(suite.T(), err)
+
+	msg.Rate = r
+	msg.From = "BLA"
+	_, err = suite.service.processRatesCardpay(msg)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorFromCurrencyNotSupported)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSourceCardpay_ProcessRatesOk() {
+	msg := &currencies.CardpayRate{
+		CreatedAt: ptypes.TimestampNow(),
+		From:      "USD",
+		To:        "RUB",
+		Rate:      r,
+		Source:    cardpaySource,
+	}
+	rates, err := suite.service.processRatesCardpay(msg)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rates, 1)
+
+	err = suite.service.saveRates(collectionRatesNameSuffixCardpay, rates)
+	assert.NoError(suite.T(), err)
+
+	req := &currencies.GetRateCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeCardpay,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+	}
+	res := &currencies.RateData{}
+
+	err = suite.service.GetRateCurrentCommon(context.TODO(), req, res)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), res.Pair, "USDRUB")
+	assert.Equal(suite.T(), res.Rate, r)
+	assert.Equal(suite.T(), res.Source, cardpaySource)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSourceCardpay_processCardpayRate_Quarantined() {
+	err := suite.service.saveRates(collectionRatesNameSuffixCardpay, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: cardpaySource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
+
+	msg := &currencies.CardpayRate{
+		CreatedAt: ptypes.TimestampNow(),
+		From:      "USD",
+		To:        "RUB",
+		Rate:      r * 1.2,
+		Source:    cardpaySource,
+	}
+	err = suite.service.processCardpayRate(msg, amqp.Delivery{})
+	assert.NoError(suite.T(), err)
+
+	batch := &quarantinedBatch{}
+	err = suite.service.db.Collection(collectionNameQuarantinedRates).Find(bson.M{"source": cardpaySource}).One(batch)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), batch.RateType, currencies.RateTypeCardpay)
+	assert.Len(suite.T(), batch.Rates, 1)
+
+	cName, err := suite.service.getCollectionName(collectionRatesNameSuffixCardpay)
+	assert.NoError(suite.T(), err)
+	n, err := suite.service.db.Collection(cName).Find(bson.M{"source": cardpaySource}).Count()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), n, 1)
+}
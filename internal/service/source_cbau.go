@@ -3,9 +3,10 @@ package service
 import (
 	"encoding/xml"
 	"errors"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 const (
@@ -14,6 +15,7 @@ const (
 	errorCbauSaveRatesFailed       = "CBAU Rates save data failed"
 	errorCbauNoResults             = "CBAU Rates no results"
 	errorCbauRateDataNotFound      = "CBAU Rate data not found"
+	errorCbauDateInvalid           = "CBAU Rates date invalid"
 
 	cbauTo     = "AUD"
 	cbauSource = "CBAU"
@@ -34,43 +36,44 @@ type cbauResponseStatistics struct {
 }
 
 type cbauResponseExchangeRate struct {
-	TargetCurrency string                  `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ targetCurrency"`
-	Observation    cbauResponseObservation `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ observation"`
+	TargetCurrency    string                        `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ targetCurrency"`
+	Observation       cbauResponseObservation       `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ observation"`
+	ObservationPeriod cbauResponseObservationPeriod `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ observationPeriod"`
 }
 
 type cbauResponseObservation struct {
 	Value float64 `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ value"`
 }
 
-// RequestRatesCbau - retriving current rates from Central bank of Australia
-func (s *Service) RequestRatesCbau() error {
-	zap.S().Info("Requesting rates from CBAU")
+// cbauResponseObservationPeriod - the date of the rate, like 2019-04-29
+type cbauResponseObservationPeriod struct {
+	Period string `xml:"http://www.cbwiki.net/wiki/index.php/Specification_1.2/ period"`
+}
+
+func init() {
+	RegisterRateSource(NewRateSource(cbauSource, currencies.RateTypeCentralbanks, cbauTo, (*Service).fetchRatesCbau))
+}
 
+// fetchRatesCbau - retriving current rates from Central bank of Australia
+func (s *Service) fetchRatesCbau() ([]interface{}, error) {
 	resp, err := s.sendRequestCbau()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbau(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rates, err := s.processRatesCbau(res)
 	if err != nil {
 		zap.S().Errorw(errorCbauSaveRatesFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbauSaveRatesFailed, err)
-		return err
-	}
-
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
-	if err != nil {
-		return err
+		return nil, err
 	}
 
-	zap.S().Info("Rates from CBAU updated")
-
-	return nil
+	return rates, nil
 }
 
 func (s *Service) sendRequestCbau() (*http.Response, error) {
@@ -111,8 +114,8 @@ func (s *Service) processRatesCbau(res *cbauResponse) ([]interface{}, error) {
 
 	var rates []interface{}
 
-	ln := len(s.cfg.RatesRequestCurrencies)
-	if s.contains(s.cfg.RatesRequestCurrenciesParsed, cbauTo) {
+	ln := len(s.getConfig().RatesRequestCurrencies)
+	if s.contains(s.getConfig().RatesRequestCurrenciesParsed, cbauTo) {
 		ln--
 	}
 	counter := make(map[string]bool, ln)
@@ -121,7 +124,7 @@ func (s *Service) processRatesCbau(res *cbauResponse) ([]interface{}, error) {
 
 		cFrom := rateItem.Statistics.ExchangeRate.TargetCurrency
 
-		if !s.contains(s.cfg.RatesRequestCurrenciesParsed, cFrom) {
+		if !s.contains(s.getConfig().RatesRequestCurrenciesParsed, cFrom) {
 			continue
 		}
 
@@ -131,21 +134,36 @@ func (s *Service) processRatesCbau(res *cbauResponse) ([]interface{}, error) {
 
 		rate := rateItem.Statistics.ExchangeRate.Observation.Value
 
-		// direct pair
-		rates = append(rates, &currencies.RateData{
-			Pair:   cFrom + cbauTo,
-			Rate:   s.toPrecise(rate),
-			Source: cbauSource,
-			Volume: 1,
-		})
-
-		// inverse pair
-		rates = append(rates, &currencies.RateData{
-			Pair:   cbauTo + cFrom,
-			Rate:   s.toPrecise(1 / rate),
-			Source: cbauSource,
-			Volume: 1,
-		})
+		pairRates := []interface{}{
+			// direct pair
+			&currencies.RateData{
+				Pair:   cFrom + cbauTo,
+				Rate:   s.toPrecise(rate),
+				Source: cbauSource,
+				Volume: 1,
+			},
+			// inverse pair
+			&currencies.RateData{
+				Pair:   cbauTo + cFrom,
+				Rate:   s.toPrecise(1 / rate),
+				Source: cbauSource,
+				Volume: 1,
+			},
+		}
+
+		// each item of the feed has its own observation date
+		period := rateItem.Statistics.ExchangeRate.ObservationPeriod.Period
+		effectiveDate, err := time.Parse(dateFormatLayout, period)
+		if err != nil {
+			zap.S().Errorw(errorCbauDateInvalid, "error", err, "date", period, "currency", cFrom)
+			return nil, err
+		}
+
+		if err = s.setRatesEffectiveDate(pairRates, effectiveDate); err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, pairRates...)
 
 		counter[cFrom] = true
 		if len(counter) == ln {
@@ -153,7 +171,7 @@ func (s *Service) processRatesCbau(res *cbauResponse) ([]interface{}, error) {
 		}
 	}
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 		if cFrom == cbauTo {
 			continue
 		}
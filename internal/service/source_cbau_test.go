@@ -2,7 +2,7 @@ package service
 
 import (
 	"github.com/globalsign/mgo/bson"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -12,7 +12,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRatesCbau_Ok() {
 	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.RequestRatesCbau()
+	err = suite.service.RequestRates(cbauSource)
 	assert.NoError(suite.T(), err)
 
 	res := &currencies.RateData{}
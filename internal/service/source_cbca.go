@@ -3,7 +3,7 @@ package service
 import (
 	"errors"
 	"fmt"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
 	"strconv"
@@ -18,11 +18,15 @@ const (
 	errorCbcaNoResults             = "CBCA Rates no results"
 	errorCbcaRateDataNotFound      = "CBCA Rate data not found"
 	errorCbcaRateDataInvalidFormat = "CBCA Rate data has invalid format"
+	errorCbcaDateInvalid           = "CBCA Rates date invalid"
 
 	cbcaTo          = "CAD"
 	cbcaSource      = "CBCA"
 	cbcaUrlTemplate = "https://www.bankofcanada.ca/valet/observations/group/FX_RATES_DAILY/json?start_date=%s"
 
+	cbcaHistoryUrlTemplate = cbcaUrlTemplate + "&end_date=%s"
+	cbcaKeyDate            = "d"
+
 	cbcaKeyMask = "FX%s%s"
 )
 
@@ -30,48 +34,76 @@ type cbcaResponse struct {
 	Observations []map[string]interface{} `json:"observations"`
 }
 
-// RequestRatesCbca - retriving current rates from Central bank of Canada
-func (s *Service) RequestRatesCbca() error {
-	zap.S().Info("Requesting rates from CBCA")
+func init() {
+	RegisterRateSource(NewHistoricalRateSource(
+		cbcaSource,
+		currencies.RateTypeCentralbanks,
+		cbcaTo,
+		(*Service).fetchRatesCbca,
+		(*Service).fetchHistoryCbca,
+	))
+}
+
+// fetchRatesCbca - retriving current rates from Central bank of Canada
+func (s *Service) fetchRatesCbca() ([]interface{}, error) {
+	d := time.Now().AddDate(0, 0, -7)
 
-	resp, err := s.sendRequestCbca()
+	resp, err := s.sendRequestCbca(fmt.Sprintf(cbcaUrlTemplate, d.Format(dateFormatLayout)))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbca(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rates, err := s.processRatesCbca(res)
 	if err != nil {
 		zap.S().Errorw(errorCbcaProcessRatesFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbcaProcessRatesFailed, err)
-		return err
+		return nil, err
 	}
 
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	return rates, nil
+}
+
+// fetchHistoryCbca - retriving rates from Central bank of Canada for passed dates range
+func (s *Service) fetchHistoryCbca(from, to time.Time) ([]interface{}, error) {
+	resp, err := s.sendRequestCbca(fmt.Sprintf(cbcaHistoryUrlTemplate, from.Format(dateFormatLayout), to.Format(dateFormatLayout)))
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	res, err := s.parseResponseCbca(resp)
+	if err != nil {
+		return nil, err
 	}
 
-	zap.S().Info("Rates from CBCA updated")
+	var rates []interface{}
+
+	for _, observation := range res.Observations {
+		dayRates, err := s.processRatesCbca(&cbcaResponse{Observations: []map[string]interface{}{observation}})
+		if err != nil {
+			zap.S().Errorw(errorCbcaProcessRatesFailed, "error", err, "date", observation[cbcaKeyDate])
+			s.sendCentrifugoMessage(errorCbcaProcessRatesFailed, err)
+			return nil, err
+		}
 
-	return nil
+		rates = append(rates, dayRates...)
+	}
+
+	return rates, nil
 }
 
-func (s *Service) sendRequestCbca() (*http.Response, error) {
+func (s *Service) sendRequestCbca(rawUrl string) (*http.Response, error) {
 	headers := map[string]string{
 		headerContentType: mimeApplicationJSON,
 		headerAccept:      mimeApplicationJSON,
 		headerUserAgent:   defaultUserAgent,
 	}
 
-	today := time.Now()
-	d := today.AddDate(0, 0, -7)
-
-	reqUrl, err := s.validateUrl(fmt.Sprintf(cbcaUrlTemplate, d.Format(dateFormatLayout)))
+	reqUrl, err := s.validateUrl(rawUrl)
 	if err != nil {
 		zap.S().Errorw(errorCbcaUrlValidationFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbcaUrlValidationFailed, err)
@@ -111,7 +143,7 @@ func (s *Service) processRatesCbca(res *cbcaResponse) ([]interface{}, error) {
 
 	lastRates := res.Observations[len(res.Observations)-1]
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 
 		if cFrom == cbcaTo {
 			continue
@@ -153,5 +185,16 @@ func (s *Service) processRatesCbca(res *cbcaResponse) ([]interface{}, error) {
 		})
 	}
 
+	rawDate, _ := lastRates[cbcaKeyDate].(string)
+	effectiveDate, err := time.Parse(dateFormatLayout, rawDate)
+	if err != nil {
+		zap.S().Errorw(errorCbcaDateInvalid, "error", err, "date", rawDate)
+		return nil, err
+	}
+
+	if err = s.setRatesEffectiveDate(rates, effectiveDate); err != nil {
+		return nil, err
+	}
+
 	return rates, nil
 }
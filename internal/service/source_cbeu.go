@@ -4,10 +4,11 @@ import (
 	"encoding/xml"
 	"errors"
 	"fmt"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/satori/go.uuid"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 const (
@@ -17,10 +18,15 @@ const (
 	errorCbeuProcessRatesFailed    = "CBEU Rates save data failed"
 	errorCbeuNoResults             = "CBEU Rates no results"
 	errorCbeuRateDataNotFound      = "CBEU Rate data not found"
+	errorCbeuDateInvalid           = "CBEU Rates date invalid"
 
 	cbeuTo          = "EUR"
 	cbeuSource      = "CBEU"
 	cbeuUrlTemplate = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml?%s"
+
+	cbeuHistory90dUrlTemplate = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist-90d.xml?%s"
+	cbeuHistoryUrlTemplate    = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-hist.xml?%s"
+	cbeuHistory90dDays        = 89
 )
 
 type cbeuResponse struct {
@@ -35,53 +41,111 @@ type cbeuResponseCube1 struct {
 
 type cbeuResponseCube2 struct {
 	XMLName xml.Name            `xml:"Cube"`
+	Time    string              `xml:"time,attr"`
 	Rates   []cbeuResponseCube3 `xml:"Cube"`
 }
 
+type cbeuHistoryResponse struct {
+	XMLName xml.Name                 `xml:"http://www.gesmes.org/xml/2002-08-01 Envelope"`
+	Data    cbeuHistoryResponseCube1 `xml:"Cube"`
+}
+
+type cbeuHistoryResponseCube1 struct {
+	XMLName xml.Name            `xml:"Cube"`
+	Days    []cbeuResponseCube2 `xml:"Cube"`
+}
+
 type cbeuResponseCube3 struct {
 	XMLName      xml.Name `xml:"Cube"`
 	CurrencyCode string   `xml:"currency,attr"`
 	Value        float64  `xml:"rate,attr"`
 }
 
-// RequestRatesCbeu - retriving current rates from European Central bank
-func (s *Service) RequestRatesCbeu() error {
-	zap.S().Info("Requesting rates from CBEU")
+func init() {
+	RegisterRateSource(NewHistoricalRateSource(
+		cbeuSource,
+		currencies.RateTypeCentralbanks,
+		cbeuTo,
+		(*Service).fetchRatesCbeu,
+		(*Service).fetchHistoryCbeu,
+	))
+}
 
-	resp, err := s.sendRequestCbeu()
+// fetchRatesCbeu - retriving current rates from European Central bank
+func (s *Service) fetchRatesCbeu() ([]interface{}, error) {
+	resp, err := s.sendRequestCbeu(cbeuUrlTemplate)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbeu(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rates, err := s.processRatesCbeu(res)
 	if err != nil {
 		zap.S().Errorw(errorCbeuProcessRatesFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbeuProcessRatesFailed, err)
-		return err
+		return nil, err
 	}
 
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	return rates, nil
+}
+
+// fetchHistoryCbeu - retriving rates from European Central bank for passed dates range
+func (s *Service) fetchHistoryCbeu(from, to time.Time) ([]interface{}, error) {
+	urlTemplate := cbeuHistoryUrlTemplate
+	if time.Since(from) < cbeuHistory90dDays*24*time.Hour {
+		urlTemplate = cbeuHistory90dUrlTemplate
+	}
+
+	resp, err := s.sendRequestCbeu(urlTemplate)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	res := &cbeuHistoryResponse{}
+	err = s.decodeXml(resp, res)
+	if err != nil {
+		zap.S().Errorw(errorCbeuResponseParsingFailed, "error", err)
+		s.sendCentrifugoMessage(errorCbeuResponseParsingFailed, err)
+		return nil, err
 	}
 
-	zap.S().Info("Rates from CBEU updated")
+	var rates []interface{}
+
+	for _, day := range res.Data.Days {
+		effectiveDate, err := time.Parse(dateFormatLayout, day.Time)
+		if err != nil {
+			zap.S().Errorw(errorCbeuDateInvalid, "error", err, "date", day.Time)
+			return nil, err
+		}
+
+		if effectiveDate.Before(from) || effectiveDate.After(to) {
+			continue
+		}
+
+		dayRates, err := s.processRatesCbeu(&cbeuResponse{Data: cbeuResponseCube1{Rates: day}})
+		if err != nil {
+			zap.S().Errorw(errorCbeuProcessRatesFailed, "error", err, "date", day.Time)
+			s.sendCentrifugoMessage(errorCbeuProcessRatesFailed, err)
+			return nil, err
+		}
+
+		rates = append(rates, dayRates...)
+	}
 
-	return nil
+	return rates, nil
 }
 
-func (s *Service) sendRequestCbeu() (*http.Response, error) {
+func (s *Service) sendRequestCbeu(urlTemplate string) (*http.Response, error) {
 	headers := map[string]string{
 		headerContentType: mimeApplicationXML,
 		headerAccept:      mimeApplicationXML,
 	}
 
-	reqUrl, err := s.validateUrl(fmt.Sprintf(cbeuUrlTemplate, uuid.NewV4().String()))
+	reqUrl, err := s.validateUrl(fmt.Sprintf(urlTemplate, uuid.NewV4().String()))
 
 	if err != nil {
 		zap.S().Errorw(errorCbeuUrlValidationFailed, "error", err)
@@ -120,15 +184,15 @@ func (s *Service) processRatesCbeu(res *cbeuResponse) ([]interface{}, error) {
 
 	var rates []interface{}
 
-	ln := len(s.cfg.RatesRequestCurrencies)
-	if s.contains(s.cfg.RatesRequestCurrenciesParsed, cbeuTo) {
+	ln := len(s.getConfig().RatesRequestCurrencies)
+	if s.contains(s.getConfig().RatesRequestCurrenciesParsed, cbeuTo) {
 		ln--
 	}
 	counter := make(map[string]bool, ln)
 
 	for _, rateItem := range res.Data.Rates.Rates {
 
-		if !s.contains(s.cfg.RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
+		if !s.contains(s.getConfig().RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
 			continue
 		}
 
@@ -158,7 +222,7 @@ func (s *Service) processRatesCbeu(res *cbeuResponse) ([]interface{}, error) {
 		}
 	}
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 		if cFrom == cbeuTo {
 			continue
 		}
@@ -167,5 +231,15 @@ func (s *Service) processRatesCbeu(res *cbeuResponse) ([]interface{}, error) {
 		}
 	}
 
+	effectiveDate, err := time.Parse(dateFormatLayout, res.Data.Rates.Time)
+	if err != nil {
+		zap.S().Errorw(errorCbeuDateInvalid, "error", err, "date", res.Data.Rates.Time)
+		return nil, err
+	}
+
+	if err = s.setRatesEffectiveDate(rates, effectiveDate); err != nil {
+		return nil, err
+	}
+
 	return rates, nil
 }
@@ -3,9 +3,11 @@ package service
 import (
 	"encoding/xml"
 	"errors"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	"fmt"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 const (
@@ -14,61 +16,97 @@ const (
 	errorCbplProcessRatesFailed    = "CBPL Rates save data failed"
 	errorCbplNoResults             = "CBPL Rates no results"
 	errorCbplRateDataNotFound      = "CBPL Rate data not found"
+	errorCbplDateInvalid           = "CBPL Rates date invalid"
 
 	cbplTo     = "PLN"
 	cbplSource = "CBPL"
-	cbplUrl    = "https://www.nbp.pl/kursy/xml/en/19a092en.xml"
+	// the current table of middle rates, the tables have the official effective date
+	cbplUrl = "https://api.nbp.pl/api/exchangerates/tables/A/?format=xml"
+
+	cbplHistoryUrlTemplate = "https://api.nbp.pl/api/exchangerates/tables/A/%s/%s/?format=xml"
+	// NBP api returns not more than 93 days per request
+	cbplHistoryMaxDays = 93
 )
 
 type cbplResponse struct {
-	XMLName xml.Name           `xml:"exchange_rates"`
-	Rates   []cbplResponseRate `xml:"mid-rate"`
+	XMLName xml.Name            `xml:"ArrayOfExchangeRatesTable"`
+	Tables  []cbplResponseTable `xml:"ExchangeRatesTable"`
+}
+
+type cbplResponseTable struct {
+	EffectiveDate string             `xml:"EffectiveDate"`
+	Rates         []cbplResponseRate `xml:"Rates>Rate"`
 }
 
 type cbplResponseRate struct {
-	CurrencyCode string  `xml:"code,attr"`
-	Units        float64 `xml:"units,attr"`
-	Value        float64 `xml:",chardata"`
+	Code string  `xml:"Code"`
+	Mid  float64 `xml:"Mid"`
 }
 
-// RequestRatesCbpl - retriving current rates from Central bank of Poland
-func (s *Service) RequestRatesCbpl() error {
-	zap.S().Info("Requesting rates from CBPL")
+func init() {
+	RegisterRateSource(NewHistoricalRateSource(
+		cbplSource,
+		currencies.RateTypeCentralbanks,
+		cbplTo,
+		(*Service).fetchRatesCbpl,
+		(*Service).fetchHistoryCbpl,
+	))
+}
 
-	resp, err := s.sendRequestCbpl()
+// fetchRatesCbpl - retriving current rates from Central bank of Poland
+func (s *Service) fetchRatesCbpl() ([]interface{}, error) {
+	resp, err := s.sendRequestCbpl(cbplUrl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbpl(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	rates, err := s.processRatesCbpl(res)
-	if err != nil {
-		zap.S().Errorw(errorCbplProcessRatesFailed, "error", err)
-		s.sendCentrifugoMessage(errorCbplProcessRatesFailed, err)
-		return err
-	}
+	return s.processTablesCbpl(res)
+}
 
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
-	if err != nil {
-		return err
-	}
+// fetchHistoryCbpl - retriving rates from Central bank of Poland for passed dates range
+func (s *Service) fetchHistoryCbpl(from, to time.Time) ([]interface{}, error) {
+	var rates []interface{}
+
+	for start := from; !start.After(to); start = start.AddDate(0, 0, cbplHistoryMaxDays) {
+		end := start.AddDate(0, 0, cbplHistoryMaxDays-1)
+		if end.After(to) {
+			end = to
+		}
+
+		reqUrl := fmt.Sprintf(cbplHistoryUrlTemplate, start.Format(dateFormatLayout), end.Format(dateFormatLayout))
+		resp, err := s.sendRequestCbpl(reqUrl)
+		if err != nil {
+			return nil, err
+		}
 
-	zap.S().Info("Rates from CBPL updated")
+		res, err := s.parseResponseCbpl(resp)
+		if err != nil {
+			return nil, err
+		}
 
-	return nil
+		tablesRates, err := s.processTablesCbpl(res)
+		if err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, tablesRates...)
+	}
+
+	return rates, nil
 }
 
-func (s *Service) sendRequestCbpl() (*http.Response, error) {
+func (s *Service) sendRequestCbpl(reqUrl string) (*http.Response, error) {
 	headers := map[string]string{
 		headerContentType: mimeApplicationXML,
 		headerAccept:      mimeTextXML,
 	}
 
-	resp, err := s.request(http.MethodGet, cbplUrl, nil, headers)
+	resp, err := s.request(http.MethodGet, reqUrl, nil, headers)
 
 	if err != nil {
 		zap.S().Errorw(errorCbplRequestFailed, "error", err)
@@ -91,7 +129,40 @@ func (s *Service) parseResponseCbpl(resp *http.Response) (*cbplResponse, error)
 	return res, nil
 }
 
-func (s *Service) processRatesCbpl(res *cbplResponse) ([]interface{}, error) {
+// processTablesCbpl - returns rates of each table stamped with the effective date of the table
+func (s *Service) processTablesCbpl(res *cbplResponse) ([]interface{}, error) {
+	if len(res.Tables) == 0 {
+		return nil, errors.New(errorCbplNoResults)
+	}
+
+	var rates []interface{}
+
+	for _, table := range res.Tables {
+		effectiveDate, err := time.Parse(dateFormatLayout, table.EffectiveDate)
+		if err != nil {
+			zap.S().Errorw(errorCbplDateInvalid, "error", err, "date", table.EffectiveDate)
+			return nil, err
+		}
+
+		tableRates, err := s.processRatesCbpl(table)
+		if err != nil {
+			zap.S().Errorw(errorCbplProcessRatesFailed, "error", err, "date", table.EffectiveDate)
+			s.sendCentrifugoMessage(errorCbplProcessRatesFailed, err)
+			return nil, err
+		}
+
+		if err = s.setRatesEffectiveDate(tableRates, effectiveDate); err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, tableRates...)
+	}
+
+	return rates, nil
+}
+
+// processRatesCbpl - returns rates of the table, the middle rates of the NBP api are per one unit of currency
+func (s *Service) processRatesCbpl(res cbplResponseTable) ([]interface{}, error) {
 
 	if len(res.Rates) == 0 {
 		return nil, errors.New(errorCbplNoResults)
@@ -99,29 +170,27 @@ func (s *Service) processRatesCbpl(res *cbplResponse) ([]interface{}, error) {
 
 	var rates []interface{}
 
-	ln := len(s.cfg.RatesRequestCurrencies)
-	if s.contains(s.cfg.RatesRequestCurrenciesParsed, cbplTo) {
+	ln := len(s.getConfig().RatesRequestCurrencies)
+	if s.contains(s.getConfig().RatesRequestCurrenciesParsed, cbplTo) {
 		ln--
 	}
 	counter := make(map[string]bool, ln)
 
 	for _, rateItem := range res.Rates {
 
-		if !s.contains(s.cfg.RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
+		if !s.contains(s.getConfig().RatesRequestCurrenciesParsed, rateItem.Code) {
 			continue
 		}
 
-		if rateItem.CurrencyCode == cbplTo {
+		if rateItem.Code == cbplTo {
 			continue
 		}
 
-		rate := rateItem.Value
-
-		rateByNominal := rate / rateItem.Units
+		rateByNominal := rateItem.Mid
 
 		// direct pair
 		rates = append(rates, &currencies.RateData{
-			Pair:   rateItem.CurrencyCode + cbplTo,
+			Pair:   rateItem.Code + cbplTo,
 			Rate:   s.toPrecise(rateByNominal),
 			Source: cbplSource,
 			Volume: 1,
@@ -129,19 +198,19 @@ func (s *Service) processRatesCbpl(res *cbplResponse) ([]interface{}, error) {
 
 		// inverse pair
 		rates = append(rates, &currencies.RateData{
-			Pair:   cbplTo + rateItem.CurrencyCode,
+			Pair:   cbplTo + rateItem.Code,
 			Rate:   s.toPrecise(1 / rateByNominal),
 			Source: cbplSource,
 			Volume: 1,
 		})
 
-		counter[rateItem.CurrencyCode] = true
+		counter[rateItem.Code] = true
 		if len(counter) == ln {
 			break
 		}
 	}
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 		if cFrom == cbplTo {
 			continue
 		}
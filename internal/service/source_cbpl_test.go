@@ -2,7 +2,7 @@ package service
 
 import (
 	"github.com/globalsign/mgo/bson"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -11,7 +11,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRatesCbpl_Ok() {
 	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.RequestRatesCbpl()
+	err = suite.service.RequestRates(cbplSource)
 	assert.NoError(suite.T(), err)
 
 	res := &currencies.RateData{}
@@ -3,11 +3,13 @@ package service
 import (
 	"encoding/xml"
 	"errors"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	"fmt"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 const (
@@ -17,14 +19,20 @@ const (
 	errorCbrfProcessRatesFailed    = "CBRF Rates save data failed"
 	errorCbrfNoResults             = "CBRF Rates no results"
 	errorCbrfRateDataNotFound      = "CBRF Rate data not found"
+	errorCbrfDateInvalid           = "CBRF Rates date invalid"
 
 	cbrfTo     = "RUB"
 	cbrfSource = "CBRF"
 	cbrfUrl    = "https://www.cbr.ru/scripts/XML_daily.asp"
+
+	cbrfHistoryUrlTemplate = "https://www.cbr.ru/scripts/XML_daily.asp?date_req=%s"
+	cbrfRequestDateLayout  = "02/01/2006"
+	cbrfResponseDateLayout = "02.01.2006"
 )
 
 type cbrfResponse struct {
 	XMLName xml.Name           `xml:"ValCurs"`
+	Date    string             `xml:"Date,attr"`
 	Rates   []cbrfResponseRate `xml:"Valute"`
 }
 
@@ -35,38 +43,75 @@ type cbrfResponseRate struct {
 	Nominal      float64  `xml:"Nominal"`
 }
 
-// RequestRatesCbrf - retriving current rates from Central bank of Russia
-func (s *Service) RequestRatesCbrf() error {
-	zap.S().Info("Requesting rates from CBRF")
+func init() {
+	RegisterRateSource(NewHistoricalRateSource(
+		cbrfSource,
+		currencies.RateTypeCentralbanks,
+		cbrfTo,
+		(*Service).fetchRatesCbrf,
+		(*Service).fetchHistoryCbrf,
+	))
+}
 
-	resp, err := s.sendRequestCbrf()
+// fetchRatesCbrf - retriving current rates from Central bank of Russia
+func (s *Service) fetchRatesCbrf() ([]interface{}, error) {
+	resp, err := s.sendRequestCbrf(cbrfUrl)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbrf(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rates, err := s.processRatesCbrf(res)
 	if err != nil {
 		zap.S().Errorw(errorCbrfProcessRatesFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbrfProcessRatesFailed, err)
-		return err
+		return nil, err
 	}
 
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
-	if err != nil {
-		return err
-	}
+	return rates, nil
+}
 
-	zap.S().Info("Rates from CBRF updated")
+// fetchHistoryCbrf - retriving rates from Central bank of Russia for each day of passed range
+func (s *Service) fetchHistoryCbrf(from, to time.Time) ([]interface{}, error) {
+	var rates []interface{}
+
+	// for weekends and holidays CBRF returns the rates of the nearest business day
+	processed := make(map[string]bool)
+
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		resp, err := s.sendRequestCbrf(fmt.Sprintf(cbrfHistoryUrlTemplate, date.Format(cbrfRequestDateLayout)))
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := s.parseResponseCbrf(resp)
+		if err != nil {
+			return nil, err
+		}
 
-	return nil
+		if processed[res.Date] {
+			continue
+		}
+		processed[res.Date] = true
+
+		dayRates, err := s.processRatesCbrf(res)
+		if err != nil {
+			zap.S().Errorw(errorCbrfProcessRatesFailed, "error", err, "date", res.Date)
+			s.sendCentrifugoMessage(errorCbrfProcessRatesFailed, err)
+			return nil, err
+		}
+
+		rates = append(rates, dayRates...)
+	}
+
+	return rates, nil
 }
 
-func (s *Service) sendRequestCbrf() (*http.Response, error) {
+func (s *Service) sendRequestCbrf(reqUrl string) (*http.Response, error) {
 	headers := map[string]string{
 		headerContentType: mimeApplicationXML,
 		headerAccept:      mimeApplicationXML,
@@ -74,7 +119,7 @@ func (s *Service) sendRequestCbrf() (*http.Response, error) {
 	}
 
 	// here may be 302 redirect in answer - https://toster.ru/q/149039
-	resp, err := s.request(http.MethodGet, cbrfUrl, nil, headers)
+	resp, err := s.request(http.MethodGet, reqUrl, nil, headers)
 
 	if err != nil {
 		zap.S().Errorw(errorCbrfRequestFailed, "error", err)
@@ -106,15 +151,15 @@ func (s *Service) processRatesCbrf(res *cbrfResponse) ([]interface{}, error) {
 
 	var rates []interface{}
 
-	ln := len(s.cfg.RatesRequestCurrencies)
-	if s.contains(s.cfg.RatesRequestCurrenciesParsed, cbrfTo) {
+	ln := len(s.getConfig().RatesRequestCurrencies)
+	if s.contains(s.getConfig().RatesRequestCurrenciesParsed, cbrfTo) {
 		ln--
 	}
 	counter := make(map[string]bool, ln)
 
 	for _, rateItem := range res.Rates {
 
-		if !s.contains(s.cfg.RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
+		if !s.contains(s.getConfig().RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
 			continue
 		}
 
@@ -153,7 +198,7 @@ func (s *Service) processRatesCbrf(res *cbrfResponse) ([]interface{}, error) {
 		}
 	}
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 		if cFrom == cbrfTo {
 			continue
 		}
@@ -162,5 +207,15 @@ func (s *Service) processRatesCbrf(res *cbrfResponse) ([]interface{}, error) {
 		}
 	}
 
+	effectiveDate, err := time.Parse(cbrfResponseDateLayout, res.Date)
+	if err != nil {
+		zap.S().Errorw(errorCbrfDateInvalid, "error", err, "date", res.Date)
+		return nil, err
+	}
+
+	if err = s.setRatesEffectiveDate(rates, effectiveDate); err != nil {
+		return nil, err
+	}
+
 	return rates, nil
 }
@@ -3,9 +3,10 @@ package service
 import (
 	"encoding/xml"
 	"errors"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
+	"time"
 )
 
 const (
@@ -16,14 +17,18 @@ const (
 	errorCbtrRateDataNotFound      = "CBTR Rate data not found"
 	errorCbtrNotSupported          = "CBTR Rate for curency not supported"
 	errorCbtrRateUnitZero          = "CBTR Rate unit is zero"
+	errorCbtrDateInvalid           = "CBTR Rates date invalid"
 
 	cbtrTo     = "TRY"
 	cbtrSource = "CBTR"
 	cbtrUrl    = "https://www.tcmb.gov.tr/kurlar/today.xml"
+
+	cbtrResponseDateLayout = "01/02/2006"
 )
 
 type cbtrResponse struct {
 	XMLName xml.Name           `xml:"Tarih_Date"`
+	Date    string             `xml:"Date,attr"`
 	Rates   []cbtrResponseRate `xml:"Currency"`
 }
 
@@ -37,34 +42,30 @@ type cbtrResponseRate struct {
 	BanknoteSelling float64  `xml:"BanknoteSelling"`
 }
 
-func (s *Service) RequestRatesCbtr() error {
-	zap.S().Info("Requesting rates from CBTR")
+func init() {
+	RegisterRateSource(NewRateSource(cbtrSource, currencies.RateTypeCentralbanks, cbtrTo, (*Service).fetchRatesCbtr))
+}
 
+// fetchRatesCbtr - retriving current rates from Central bank of Turkey
+func (s *Service) fetchRatesCbtr() ([]interface{}, error) {
 	resp, err := s.sendRequestCbtr()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	res, err := s.parseResponseCbtr(resp)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	rates, err := s.processRatesCbtr(res)
 	if err != nil {
 		zap.S().Errorw(errorCbtrProcessRatesFailed, "error", err)
 		s.sendCentrifugoMessage(errorCbtrProcessRatesFailed, err)
-		return err
-	}
-
-	err = s.saveRates(collectionRatesNameSuffixCentralbanks, rates)
-	if err != nil {
-		return err
+		return nil, err
 	}
 
-	zap.S().Info("Rates from CBTR updated")
-
-	return nil
+	return rates, nil
 }
 
 func (s *Service) sendRequestCbtr() (*http.Response, error) {
@@ -106,15 +107,15 @@ func (s *Service) processRatesCbtr(res *cbtrResponse) ([]interface{}, error) {
 
 	var rates []interface{}
 
-	ln := len(s.cfg.RatesRequestCurrencies)
-	if s.contains(s.cfg.RatesRequestCurrenciesParsed, cbtrTo) {
+	ln := len(s.getConfig().RatesRequestCurrencies)
+	if s.contains(s.getConfig().RatesRequestCurrenciesParsed, cbtrTo) {
 		ln--
 	}
 	counter := make(map[string]bool, ln)
 
 	for _, rateItem := range res.Rates {
 
-		if !s.contains(s.cfg.RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
+		if !s.contains(s.getConfig().RatesRequestCurrenciesParsed, rateItem.CurrencyCode) {
 			continue
 		}
 
@@ -162,7 +163,7 @@ func (s *Service) processRatesCbtr(res *cbtrResponse) ([]interface{}, error) {
 		}
 	}
 
-	for _, cFrom := range s.cfg.RatesRequestCurrencies {
+	for _, cFrom := range s.getConfig().RatesRequestCurrencies {
 		if cFrom == cbtrTo {
 			continue
 		}
@@ -171,5 +172,15 @@ func (s *Service) processRatesCbtr(res *cbtrResponse) ([]interface{}, error) {
 		}
 	}
 
+	effectiveDate, err := time.Parse(cbtrResponseDateLayout, res.Date)
+	if err != nil {
+		zap.S().Errorw(errorCbtrDateInvalid, "error", err, "date", res.Date)
+		return nil, err
+	}
+
+	if err = s.setRatesEffectiveDate(rates, effectiveDate); err != nil {
+		return nil, err
+	}
+
 	return rates, nil
 }
@@ -2,7 +2,7 @@ package service
 
 import (
 	"github.com/globalsign/mgo/bson"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -14,9 +14,9 @@ func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRatesTcmb_Ok() {
 	err := suite.CleanRatesCollection(collectionRatesNameSuffixCentralbanks)
 	shouldBe.NoError(err)
 
-	err = suite.service.RequestRatesCbtr()
+	err = suite.service.RequestRates(cbtrSource)
 	shouldBe.NoError(err)
-	err = suite.service.RequestRatesOxr()
+	err = suite.service.RequestRates(oxrSource)
 	shouldBe.NoError(err)
 
 	res := &currencies.RateData{}
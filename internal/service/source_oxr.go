@@ -3,11 +3,12 @@ package service
 import (
 	"errors"
 	"fmt"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
 const (
@@ -20,7 +21,8 @@ const (
 
 	oxrSource = "OXR"
 
-	oxrUrlTemplate = "https://openexchangerates.org/api/latest.json?base=%s&%s"
+	oxrUrlTemplate        = "https://openexchangerates.org/api/latest.json?base=%s&%s"
+	oxrHistoryUrlTemplate = "https://openexchangerates.org/api/historical/%s.json?base=%s&%s"
 )
 
 type oxrResponse struct {
@@ -31,52 +33,84 @@ type oxrResponse struct {
 	Rates      map[string]float64
 }
 
-// RequestRatesOxr - retriving current rates from openexchangerates.org
-func (s *Service) RequestRatesOxr() error {
-	zap.S().Info("Requesting rates from OXR")
+func init() {
+	RegisterRateSource(NewHistoricalRateSource(
+		oxrSource,
+		currencies.RateTypeOxr,
+		"",
+		(*Service).fetchRatesOxr,
+		(*Service).fetchHistoryOxr,
+	))
+}
+
+// fetchRatesOxr - retriving current rates from openexchangerates.org
+func (s *Service) fetchRatesOxr() ([]interface{}, error) {
+	return s.fetchRatesOxrByUrl(oxrUrlTemplate)
+}
+
+// fetchHistoryOxr - retriving rates from openexchangerates.org for each day of passed dates range
+func (s *Service) fetchHistoryOxr(from, to time.Time) ([]interface{}, error) {
+	var rates []interface{}
+
+	for date := from; !date.After(to); date = date.AddDate(0, 0, 1) {
+		urlTemplate := fmt.Sprintf(oxrHistoryUrlTemplate, date.Format(dateFormatLayout), "%s", "%s")
+
+		dayRates, err := s.fetchRatesOxrByUrl(urlTemplate)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = s.setRatesEffectiveDate(dayRates, date); err != nil {
+			return nil, err
+		}
+
+		rates = append(rates, dayRates...)
+	}
 
+	return rates, nil
+}
+
+func (s *Service) fetchRatesOxrByUrl(urlTemplate string) ([]interface{}, error) {
 	queryParams := url.Values{
-		"app_id":  []string{s.cfg.OxrAppId},
-		"symbols": []string{strings.Join(s.cfg.RatesRequestCurrencies, ",")},
+		"app_id":  []string{s.getConfig().OxrAppId},
+		"symbols": []string{strings.Join(s.getConfig().RatesRequestCurrencies, ",")},
 	}
 	queryString := queryParams.Encode()
 
-	for _, from := range s.cfg.SettlementCurrencies {
+	var rates []interface{}
 
-		resp, err := s.sendRequestOxr(from, queryString)
+	for _, from := range s.getConfig().SettlementCurrencies {
+
+		resp, err := s.sendRequestOxr(urlTemplate, from, queryString)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		res, err := s.parseResponseOxr(resp)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		rates, err := s.processRatesOxr(res)
+		baseRates, err := s.processRatesOxr(res)
 		if err != nil {
 			zap.S().Errorw(errorOxrSaveRatesFailed, "error", err)
 			s.sendCentrifugoMessage(errorOxrSaveRatesFailed, err)
-			return err
+			return nil, err
 		}
 
-		err = s.saveRates(collectionRatesNameSuffixOxr, rates)
-		if err != nil {
-			return err
-		}
+		rates = append(rates, baseRates...)
 	}
 
-	zap.S().Info("Rates from OXR updated")
-
-	return nil
+	return rates, nil
 }
-func (s *Service) sendRequestOxr(from string, queryString string) (*http.Response, error) {
+
+func (s *Service) sendRequestOxr(urlTemplate, from, queryString string) (*http.Response, error) {
 	headers := map[string]string{
 		headerContentType: mimeApplicationJSON,
 		headerAccept:      mimeApplicationJSON,
 	}
 
-	reqUrl, err := s.validateUrl(fmt.Sprintf(oxrUrlTemplate, from, queryString))
+	reqUrl, err := s.validateUrl(fmt.Sprintf(urlTemplate, from, queryString))
 
 	if err != nil {
 		zap.S().Errorw(errorOxrUrlValidationFailed, "error", err)
@@ -137,7 +171,7 @@ func (s *Service) processRatesOxr(res *oxrResponse) ([]interface{}, error) {
 		})
 
 		// prevent duplication of inverse rates, if they will be getted as direct rates
-		if _, ok := s.cfg.OxrRatesDirectPairs[to+from]; ok {
+		if _, ok := s.getConfig().OxrRatesDirectPairs[to+from]; ok {
 			continue
 		}
 
@@ -150,5 +184,11 @@ func (s *Service) processRatesOxr(res *oxrResponse) ([]interface{}, error) {
 		})
 	}
 
+	if res.Timestamp > 0 {
+		if err := s.setRatesEffectiveDate(rates, time.Unix(res.Timestamp, 0)); err != nil {
+			return nil, err
+		}
+	}
+
 	return rates, nil
 }
@@ -3,7 +3,7 @@ package service
 import (
 	"context"
 	"github.com/globalsign/mgo"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -90,7 +90,7 @@ func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRatesOxr_Ok() {
 	err := suite.CleanRatesCollection(collectionRatesNameSuffixOxr)
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.RequestRatesOxr()
+	err = suite.service.RequestRates(oxrSource)
 	assert.NoError(suite.T(), err)
 
 	res := &currencies.RateData{}
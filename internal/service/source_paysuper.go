@@ -1,80 +1,249 @@
 package service
 
 import (
+	"errors"
+	"fmt"
 	"github.com/globalsign/mgo/bson"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
+	"strings"
+	"time"
 )
 
 const (
 	paysuperSource = "PS"
 
-	errorPaysuperRateCalc = "paysuper prediction rate calculation error"
-	errorPaysuperRateSave = "paysuper prediction rates save error"
+	// at least two rates are required to calculate the volatility
+	paysuperForecastMinRates = 2
+
+	errorPaysuperRateCalc        = "paysuper prediction rate calculation error"
+	errorPaysuperForecastParams  = "paysuper forecast params request failed"
+	errorPaysuperForecastHistory = "paysuper forecast history request failed"
+	errorPaysuperVolatilityValue = "paysuper forecast volatility factor is negative"
+	errorPaysuperRateInvalid     = "paysuper corrected rate is not positive"
 )
 
-// SetRatesPaysuper - set prediction rates for Paysuper
-func (s *Service) SetRatesPaysuper() error {
-	zap.S().Info("Start calculation of prediction rates for Paysuper")
+// paysuperForecastParams - parameters of paysuper prediction model for a pair of currencies
+type paysuperForecastParams struct {
+	Pair string `bson:"pair"`
+	// number of days of OXR rates history to calculate the moving average and the volatility on
+	WindowDays int64 `bson:"window_days"`
+	// number of standard deviations added to the moving average to hedge the settlement delay
+	VolatilityFactor float64   `bson:"volatility_factor"`
+	UpdatedAt        time.Time `bson:"updated_at"`
+}
+
+func init() {
+	RegisterRateSource(NewRateSource(paysuperSource, currencies.RateTypePaysuper, "", (*Service).fetchRatesPaysuper))
+}
 
+// fetchRatesPaysuper - calculates prediction rates for Paysuper
+func (s *Service) fetchRatesPaysuper() ([]interface{}, error) {
 	var (
 		cFrom string
 		cTo   string
 		rates []interface{}
 	)
 
-	for _, cFrom = range s.cfg.SettlementCurrencies {
-		for _, cTo = range s.cfg.RatesRequestCurrencies {
+	params, err := s.getPaysuperForecastParams()
+	if err != nil {
+		s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
+		return nil, err
+	}
+
+	items, err := s.getPaysuperCorrections()
+	if err != nil {
+		s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
+		return nil, err
+	}
+
+	corrections := make(map[string]float64, len(items))
+	for _, item := range items {
+		corrections[item.Pair] = item.Value
+	}
+
+	corridors, err := s.getPaysuperCorridors()
+	if err != nil {
+		s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
+		return nil, err
+	}
+
+	var refused []string
+
+	for _, cFrom = range s.getConfig().SettlementCurrencies {
+		for _, cTo = range s.getConfig().RatesRequestCurrencies {
 
 			if cFrom == cTo {
 				continue
 			}
 
-			rd, err := s.getRatePaysuper(cFrom, cTo)
-			if err != nil {
-				zap.S().Errorw(errorPaysuperRateCalc, "error", err)
-				s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
-				return err
-			}
-			rates = append(rates, rd)
-
-			rd, err = s.getRatePaysuper(cTo, cFrom)
-			if err != nil {
-				zap.S().Errorw(errorPaysuperRateCalc, "error", err)
-				s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
-				return err
+			// each direction is forecast on its own OXR rates, so the volatility buffer increases the rates
+			// of both directions and the rate of the pair isn't the inverse of the reverse pair rate
+			for _, pair := range [][2]string{{cFrom, cTo}, {cTo, cFrom}} {
+				name := pair[0] + pair[1]
+
+				rd, err := s.getRatePaysuper(pair[0], pair[1], params[name], corrections[name], s.getPaysuperCorridor(corridors, name))
+				if err != nil {
+					// the refused rate is not saved, so the previous rate of the pair stays current,
+					// the rates of other pairs are not affected
+					if err == errCorridorRateOutside {
+						refused = append(refused, name)
+						continue
+					}
+
+					zap.S().Errorw(errorPaysuperRateCalc, "error", err)
+					s.sendCentrifugoMessage(errorPaysuperRateCalc, err)
+					return nil, err
+				}
+				rates = append(rates, rd)
 			}
-			rates = append(rates, rd)
 		}
 	}
 
-	err := s.saveRates(collectionRatesNameSuffixPaysuper, rates)
-	if err != nil {
-		zap.S().Errorw(errorPaysuperRateSave, "error", err)
-		s.sendCentrifugoMessage(errorPaysuperRateSave, err)
-		return err
+	if len(refused) > 0 {
+		s.sendCentrifugoMessage(errorCorridorRateOutside, fmt.Errorf("pairs: %s", strings.Join(refused, ", ")))
 	}
 
-	zap.S().Info("Prediction rates for Paysuper updated")
-
-	return nil
+	return rates, nil
 }
 
-func (s *Service) getRatePaysuper(cFrom string, cTo string) (*currencies.RateData, error) {
-	res := &currencies.RateData{}
+// getRatePaysuper - calculates prediction rate as the moving average of OXR rates plus the volatility buffer
+// over the window of the pair. The latest OXR rate is used if there is not enough history for the pair.
+// The correction of the pair in percent is applied to the result, and then the result is kept
+// within the corridor around the latest OXR rate
+func (s *Service) getRatePaysuper(
+	cFrom string,
+	cTo string,
+	params *paysuperForecastParams,
+	correction float64,
+	corridor float64,
+) (*currencies.RateData, error) {
+	if params == nil {
+		params = &paysuperForecastParams{
+			WindowDays:       s.getConfig().PaysuperForecastWindow,
+			VolatilityFactor: s.getConfig().PaysuperForecastVolatilityFactor,
+		}
+	}
+
+	pair := cFrom + cTo
+	rate, n, err := s.getPaysuperForecast(pair, params)
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.getRate(collectionRatesNameSuffixOxr, cFrom, cTo, bson.M{}, "", res)
+	reference := &currencies.RateData{}
+	err = s.getRate(collectionRatesNameSuffixOxr, cFrom, cTo, bson.M{}, "", reference)
 	if err != nil {
 		return nil, err
 	}
 
+	if n < paysuperForecastMinRates {
+		rate = reference.Rate
+	}
+
 	rd := &currencies.RateData{
-		Pair:   res.Pair,
-		Rate:   res.Rate,
+		Pair:   pair,
 		Source: paysuperSource,
 		Volume: 1,
 	}
 
+	corrected := decimal.NewFromFloat(rate)
+	if correction != 0 {
+		corrected = corrected.Mul(decimal.NewFromFloat(100 + correction)).Div(decimal.NewFromInt(100))
+	}
+
+	// the corridor is disabled by default, so it doesn't guard against the correction zeroing the rate
+	if corrected.Cmp(decimal.Zero()) <= 0 {
+		zap.S().Errorw(errorPaysuperRateInvalid, "pair", pair, "rate", rate, "correction", correction)
+		return nil, errors.New(errorPaysuperRateInvalid)
+	}
+
+	corrected, err = s.applyPaysuperCorridor(pair, corrected, s.getRateDecimal(reference), corridor)
+	if err != nil {
+		return nil, err
+	}
+
+	s.setRateDecimal(rd, corrected)
+
 	return rd, nil
+}
+
+// getPaysuperForecast - returns SMA + k*σ of OXR rates of the pair over the window and the number of rates in it
+func (s *Service) getPaysuperForecast(pair string, params *paysuperForecastParams) (float64, int, error) {
+	cName, err := s.getCollectionName(collectionRatesNameSuffixOxr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	pipeline := []bson.M{
+		{
+			"$match": bson.M{
+				"pair":           pair,
+				"effective_date": bson.M{"$gte": time.Now().AddDate(0, 0, -int(params.WindowDays))},
+			},
+		},
+		{
+			"$group": bson.M{
+				"_id":    "$pair",
+				"avg":    bson.M{"$avg": "$rate"},
+				"stddev": bson.M{"$stdDevPop": "$rate"},
+				"count":  bson.M{"$sum": 1},
+			},
+		},
+	}
+
+	var res []struct {
+		Avg    float64 `bson:"avg"`
+		StdDev float64 `bson:"stddev"`
+		Count  int     `bson:"count"`
+	}
+
+	err = s.db.Collection(cName).Pipe(pipeline).All(&res)
+	if err != nil {
+		zap.L().Error(
+			errorPaysuperForecastHistory,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, cName),
+			zap.Any(pkg.ErrorDatabaseFieldQuery, pipeline),
+		)
+		return 0, 0, err
+	}
+
+	if len(res) == 0 {
+		return 0, 0, nil
+	}
+
+	return res[0].Avg + params.VolatilityFactor*res[0].StdDev, res[0].Count, nil
+}
+
+// getPaysuperForecastParams - returns model parameters stored per pair, pairs without parameters use the defaults
+func (s *Service) getPaysuperForecastParams() (map[string]*paysuperForecastParams, error) {
+	var items []*paysuperForecastParams
+
+	err := s.db.Collection(collectionNamePaysuperForecastParams).Find(nil).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorPaysuperForecastParams,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNamePaysuperForecastParams),
+		)
+		return nil, err
+	}
+
+	res := make(map[string]*paysuperForecastParams, len(items))
+	for _, item := range items {
+		if item.WindowDays <= 0 {
+			item.WindowDays = s.getConfig().PaysuperForecastWindow
+		}
+		// a negative factor would decrease the rate instead of hedging it
+		if item.VolatilityFactor < 0 {
+			zap.S().Warnw(errorPaysuperVolatilityValue, "pair", item.Pair, "volatilityFactor", item.VolatilityFactor)
+			item.VolatilityFactor = s.getConfig().PaysuperForecastVolatilityFactor
+		}
+		res[item.Pair] = item
+	}
 
+	return res, nil
 }
@@ -1,75 +1,103 @@
 package service
 
 import (
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
+	"time"
 )
 
 func (suite *CurrenciesratesServiceTestSuite) TestSource_getRatePaysuper_Ok() {
-	rd, err := suite.service.getRateStock("USD", "RUB")
+	// moving average of r-1 and r plus one standard deviation
+	rd, err := suite.service.getRatePaysuper("USD", "RUB", nil, 0, 0)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), rd.Rate, r)
+	assert.Equal(suite.T(), rd.Pair, "USDRUB")
+	assert.Equal(suite.T(), rd.Source, paysuperSource)
 
-	rd, err = suite.service.getRateStock("USD", "RUB")
+	params := &paysuperForecastParams{
+		Pair:             "USDRUB",
+		WindowDays:       30,
+		VolatilityFactor: 3,
+		UpdatedAt:        time.Now(),
+	}
+	err = suite.service.db.Collection(collectionNamePaysuperForecastParams).Insert(params)
 	assert.NoError(suite.T(), err)
-}
 
-// waiting for commercial oxr app_id
-/*
-func (suite *CurrenciesratesServiceTestSuite) TestSource_SetRatesPaysuper_Ok() {
-	// cleaning collection before test starts
-	err := suite.CleanRatesCollection(collectionRatesNameSuffixOxr)
+	paramsByPair, err := suite.service.getPaysuperForecastParams()
 	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), paramsByPair, 1)
 
-	err = suite.CleanRatesCollection(collectionRatesNameSuffixPaysuper)
+	rd, err = suite.service.getRatePaysuper("USD", "RUB", paramsByPair["USDRUB"], 0, 0)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r+1)
 
-	err = suite.service.RequestRatesOxr()
+	// negative volatility factor is replaced with the default one
+	params.VolatilityFactor = -3
+	err = suite.service.db.Collection(collectionNamePaysuperForecastParams).Update(bson.M{"pair": "USDRUB"}, params)
 	assert.NoError(suite.T(), err)
 
-	corrections := []interface{}{}
-	for _, from := range suite.config.SettlementCurrencies {
-		for _, to := range suite.config.RatesRequestCurrencies {
-			corrections = append(corrections, &paysuperCorrection{
-				Pair:      from + to,
-				Value:     1,
-				CreatedAt: time.Now(),
-			})
-			corrections = append(corrections, &paysuperCorrection{
-				Pair:      to + from,
-				Value:     1,
-				CreatedAt: time.Now(),
-			})
-		}
-	}
-	err = suite.service.db.Collection(collectionNamePaysuperCorrections).Insert(corrections...)
+	paramsByPair, err = suite.service.getPaysuperForecastParams()
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), paramsByPair["USDRUB"].VolatilityFactor, suite.service.getConfig().PaysuperForecastVolatilityFactor)
+
+	rd, err = suite.service.getRatePaysuper("USD", "RUB", nil, -1, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.RateDecimal, "63.985086")
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getRatePaysuper_LatestRate() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixOxr)
+	assert.NoError(suite.T(), err)
+
+	err = suite.service.saveRates(collectionRatesNameSuffixOxr, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: oxrSource, Volume: 1},
+	})
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.SetRatesPaysuper()
+	// one rate is not enough to calculate the volatility
+	rd, err := suite.service.getRatePaysuper("USD", "RUB", nil, 0, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.Rate, r)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getRatePaysuper_Fail() {
+	err := suite.CleanRatesCollection(collectionRatesNameSuffixOxr)
 	assert.NoError(suite.T(), err)
 
-	res := &currencies.RateData{}
+	_, err = suite.service.getRatePaysuper("USD", "RUB", nil, 0, 0)
+	assert.Error(suite.T(), err)
+}
 
-	for _, from := range suite.config.SettlementCurrencies {
-		for _, to := range suite.config.RatesRequestCurrencies {
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getRatePaysuper_ZeroRate() {
+	// the correction of -100% zeroes the rate
+	_, err := suite.service.getRatePaysuper("USD", "RUB", nil, -100, 0)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorPaysuperRateInvalid)
+}
 
-			source := paysuperSource
-			if from == to {
-				source = stubSource
-			}
+func (suite *CurrenciesratesServiceTestSuite) TestSource_fetchRatesPaysuper_CorridorRejectsPair() {
+	suite.service.getConfig().SettlementCurrencies = []string{"USD"}
+	suite.service.getConfig().RatesRequestCurrencies = []string{"RUB"}
+	suite.service.getConfig().PaysuperCorridorAction = corridorActionReject
 
-			err = suite.service.getRate(pkg.RateTypePaysuper, from, to, bson.M{}, res)
-			assert.NoError(suite.T(), err)
-			assert.True(suite.T(), res.Rate > 0)
-			assert.Equal(suite.T(), res.Pair, from+to)
-			assert.Equal(suite.T(), res.Source, source)
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, []interface{}{
+		&currencies.RateData{Pair: "RUBUSD", Rate: 1 / r, Source: oxrSource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
 
-			err = suite.service.getRate(pkg.RateTypePaysuper, to, from, bson.M{}, res)
-			assert.NoError(suite.T(), err)
-			assert.True(suite.T(), res.Rate > 0)
-			assert.Equal(suite.T(), res.Pair, to+from)
-			assert.Equal(suite.T(), res.Source, source)
-		}
+	// r+1 of the forecast is outside of 1% corridor around r
+	err = suite.service.db.Collection(collectionNamePaysuperForecastParams).Insert(
+		&paysuperForecastParams{Pair: "USDRUB", WindowDays: 30, VolatilityFactor: 3, UpdatedAt: time.Now()},
+	)
+	assert.NoError(suite.T(), err)
+	err = suite.service.db.Collection(collectionNamePaysuperCorridors).Insert(
+		&paysuperCorridor{Id: bson.NewObjectId(), Pair: "USDRUB", Value: 0.01, UpdatedAt: time.Now()},
+	)
+	assert.NoError(suite.T(), err)
 
-	}
+	rates, err := suite.service.fetchRatesPaysuper()
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), rates, 1)
+	assert.Equal(suite.T(), rates[0].(*currencies.RateData).Pair, "RUBUSD")
 }
-*/
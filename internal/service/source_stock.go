@@ -1,67 +1,153 @@
 package service
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"github.com/globalsign/mgo/bson"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
+	"github.com/paysuper/paysuper-currencies/internal/decimal"
+	"github.com/paysuper/paysuper-currencies/pkg"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"go.uber.org/zap"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const (
 	stockSource = "STOCK"
 
-	errorStockRateCalc = "stock rate calculation error"
-	errorStockRateSave = "stock rates save error"
+	stockImportExtJson = ".json"
+	stockImportExtCsv  = ".csv"
+
+	// suffix of the file name after import, so the file is not imported twice
+	stockImportDoneSuffix = ".imported"
+
+	errorStockRateCalc        = "stock rate calculation error"
+	errorStockSpreads         = "stock spreads request failed"
+	errorStockSpreadValue     = "stock spread is out of range from 0 to 100 percent"
+	errorStockImportFailed    = "stock executed prices import failed"
+	errorStockImportFormat    = "stock executed prices file format not supported"
+	errorStockImportRecord    = "stock executed price record invalid"
+	errorStockImportCsvHeader = "stock executed prices csv header invalid"
+	errorStockImportRename    = "stock executed prices file rename failed"
 )
 
-// SetRatesStock - set rates for stock exchange
-func (s *Service) SetRatesStock() error {
+// stockSpread - bid and ask spreads of a pair in percent from the OXR rate
+type stockSpread struct {
+	Pair      string    `bson:"pair"`
+	Bid       float64   `bson:"bid"`
+	Ask       float64   `bson:"ask"`
+	UpdatedAt time.Time `bson:"updated_at"`
+}
+
+// stockExecution - conversion executed by treasury
+type stockExecution struct {
+	Pair       string    `json:"pair"`
+	Side       string    `json:"side"`
+	Rate       float64   `json:"rate"`
+	Volume     float64   `json:"volume"`
+	ExecutedAt time.Time `json:"executed_at"`
+}
+
+var stockImportCsvHeader = []string{"pair", "side", "rate", "volume", "executed_at"}
+
+// stockRateSource - stock rates source, the file with executed prices is renamed on confirm,
+// so the file is imported again if the rates calculated from it are neither saved nor quarantined
+type stockRateSource struct {
+	rateSource
+}
+
+func init() {
+	RegisterRateSource(&stockRateSource{
+		rateSource: rateSource{name: stockSource, rateType: currencies.RateTypeStock},
+	})
+}
+
+// Fetch - calculates the rates without confirmation, so the imported file is read again by the next request
+func (r *stockRateSource) Fetch(s *Service) ([]interface{}, error) {
+	rates, _, err := s.fetchRatesStock()
+	return rates, err
+}
+
+// FetchConfirmed - calculates the rates and returns the path of the imported file, empty if there is no file
+func (r *stockRateSource) FetchConfirmed(s *Service) ([]interface{}, string, error) {
+	return s.fetchRatesStock()
+}
+
+// Confirm - renames the imported file, so it's not imported twice
+func (r *stockRateSource) Confirm(s *Service, path string) error {
+	if path == "" {
+		return nil
+	}
 
-	zap.S().Info("Start calculation rates for Stock")
+	return s.finishStockImport(path)
+}
 
+// fetchRatesStock - calculates buy and sell rates for stock exchange,
+// the prices executed by treasury take priority over the rates calculated with spreads.
+// Returns path of the imported file, it must be renamed after the rates are saved
+func (s *Service) fetchRatesStock() ([]interface{}, string, error) {
 	var (
 		cFrom string
 		cTo   string
 		rates []interface{}
 	)
 
-	for _, cFrom = range s.cfg.SettlementCurrencies {
-		for _, cTo = range s.cfg.RatesRequestCurrencies {
+	spreads, err := s.getStockSpreads()
+	if err != nil {
+		s.sendCentrifugoMessage(errorStockRateCalc, err)
+		return nil, "", err
+	}
+
+	executed, path, err := s.importStockExecutions()
+	if err != nil {
+		zap.S().Errorw(errorStockImportFailed, "error", err, "path", s.getConfig().StockImportPath)
+		s.sendCentrifugoMessage(errorStockImportFailed, err)
+		return nil, "", err
+	}
+
+	for _, cFrom = range s.getConfig().SettlementCurrencies {
+		for _, cTo = range s.getConfig().RatesRequestCurrencies {
 
 			if cFrom == cTo {
 				continue
 			}
 
-			rd, err := s.getRateStock(cFrom, cTo)
-			if err != nil {
-				zap.S().Errorw(errorStockRateCalc, "error", err)
-				s.sendCentrifugoMessage(errorStockRateCalc, err)
-				return err
-			}
-			rates = append(rates, rd)
+			for _, pair := range [][2]string{{cFrom, cTo}, {cTo, cFrom}} {
+				for _, side := range []string{currencies.ExchangeDirectionBuy, currencies.ExchangeDirectionSell} {
+					rd, ok := executed[pair[0]+pair[1]+side]
+					if ok {
+						rates = append(rates, rd)
+						continue
+					}
 
-			rd, err = s.getRateStock(cTo, cFrom)
-			if err != nil {
-				zap.S().Errorw(errorStockRateCalc, "error", err)
-				s.sendCentrifugoMessage(errorStockRateCalc, err)
-				return err
+					rd, err = s.getRateStock(pair[0], pair[1], side, spreads[pair[0]+pair[1]])
+					if err != nil {
+						zap.S().Errorw(errorStockRateCalc, "error", err)
+						s.sendCentrifugoMessage(errorStockRateCalc, err)
+						return nil, "", err
+					}
+					rates = append(rates, rd)
+				}
 			}
-			rates = append(rates, rd)
 		}
 	}
 
-	err := s.saveRates(collectionRatesNameSuffixStock, rates)
-	if err != nil {
-		zap.S().Errorw(errorStockRateSave, "error", err)
-		s.sendCentrifugoMessage(errorStockRateSave, err)
-		return err
-	}
-
-	zap.S().Info("Rates for Stock updated")
-
-	return nil
+	return rates, path, nil
 }
 
-func (s *Service) getRateStock(cFrom string, cTo string) (*currencies.RateData, error) {
+// getRateStock - calculates stock rate of the side from the OXR rate:
+// the buy rate is increased by the ask spread, the sell rate is decreased by the bid spread
+func (s *Service) getRateStock(cFrom, cTo, side string, spread *stockSpread) (*currencies.RateData, error) {
+	if spread == nil {
+		spread = &stockSpread{Bid: s.getConfig().StockBidSpread, Ask: s.getConfig().StockAskSpread}
+	}
+
 	res := &currencies.RateData{}
 
 	err := s.getRate(collectionRatesNameSuffixOxr, cFrom, cTo, bson.M{}, "", res)
@@ -69,12 +155,196 @@ func (s *Service) getRateStock(cFrom string, cTo string) (*currencies.RateData,
 		return nil, err
 	}
 
+	percent := -spread.Bid
+	if side == currencies.ExchangeDirectionBuy {
+		percent = spread.Ask
+	}
+
+	rate := s.getRateDecimal(res).Mul(decimal.NewFromFloat(100 + percent)).Div(decimal.NewFromInt(100))
+
 	rd := &currencies.RateData{
 		Pair:   res.Pair,
-		Rate:   res.Rate,
 		Source: stockSource,
+		Side:   side,
 		Volume: 1,
 	}
+	s.setRateDecimal(rd, rate)
 
 	return rd, nil
 }
+
+// getStockSpreads - returns spreads stored per pair, pairs without spreads use the defaults
+func (s *Service) getStockSpreads() (map[string]*stockSpread, error) {
+	var items []*stockSpread
+
+	err := s.db.Collection(collectionNameStockSpreads).Find(nil).All(&items)
+	if err != nil {
+		zap.L().Error(
+			errorStockSpreads,
+			zap.Error(err),
+			zap.String(pkg.ErrorDatabaseFieldCollection, collectionNameStockSpreads),
+		)
+		return nil, err
+	}
+
+	res := make(map[string]*stockSpread, len(items))
+	for _, item := range items {
+		// a negative spread would move the rate to the wrong side, a spread of 100% zeroes the sell rate
+		if !s.isStockSpreadValid(item.Bid) || !s.isStockSpreadValid(item.Ask) {
+			zap.S().Warnw(errorStockSpreadValue, "pair", item.Pair, "bid", item.Bid, "ask", item.Ask)
+			s.sendCentrifugoMessage(errorStockSpreadValue, fmt.Errorf("pair %s, bid %v, ask %v", item.Pair, item.Bid, item.Ask))
+		}
+		if !s.isStockSpreadValid(item.Bid) {
+			item.Bid = s.getConfig().StockBidSpread
+		}
+		if !s.isStockSpreadValid(item.Ask) {
+			item.Ask = s.getConfig().StockAskSpread
+		}
+		res[item.Pair] = item
+	}
+
+	return res, nil
+}
+
+func (s *Service) isStockSpreadValid(value float64) bool {
+	return value >= 0 && value < 100
+}
+
+// importStockExecutions - reads the file with executed conversion prices dropped by treasury
+// and returns volume weighted rates by pair and side and the path of the read file, empty if there is no file
+func (s *Service) importStockExecutions() (map[string]*currencies.RateData, string, error) {
+	path := s.getConfig().StockImportPath
+	if path == "" {
+		return nil, "", nil
+	}
+
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, "", nil
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	var items []*stockExecution
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case stockImportExtJson:
+		err = json.NewDecoder(f).Decode(&items)
+	case stockImportExtCsv:
+		items, err = s.decodeStockExecutionsCsv(f)
+	default:
+		err = errors.New(errorStockImportFormat)
+	}
+
+	_ = f.Close()
+
+	if err != nil {
+		return nil, "", err
+	}
+
+	res, err := s.getStockExecutedRates(items, time.Now())
+	if err != nil {
+		return nil, "", err
+	}
+
+	zap.S().Infow("Stock executed prices read", "path", path, "executions", len(items), "rates", len(res))
+
+	return res, path, nil
+}
+
+// finishStockImport - renames imported file, so it's not imported twice
+func (s *Service) finishStockImport(path string) error {
+	err := os.Rename(path, path+"."+time.Now().Format("20060102150405")+stockImportDoneSuffix)
+	if err != nil {
+		zap.S().Errorw(errorStockImportRename, "error", err, "path", path)
+		return err
+	}
+
+	zap.S().Infow("Stock executed prices imported", "path", path)
+
+	return nil
+}
+
+func (s *Service) decodeStockExecutionsCsv(r io.Reader) ([]*stockExecution, error) {
+	records, err := csv.NewReader(r).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 || strings.Join(records[0], ",") != strings.Join(stockImportCsvHeader, ",") {
+		return nil, errors.New(errorStockImportCsvHeader)
+	}
+
+	var items []*stockExecution
+
+	for i, record := range records[1:] {
+		item := &stockExecution{Pair: record[0], Side: record[1]}
+
+		item.Rate, err = strconv.ParseFloat(record[2], 64)
+		if err == nil {
+			item.Volume, err = strconv.ParseFloat(record[3], 64)
+		}
+		if err == nil {
+			item.ExecutedAt, err = time.Parse(time.RFC3339, record[4])
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s, line %d: %s", errorStockImportRecord, i+2, err.Error())
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// getStockExecutedRates - aggregates executions to volume weighted rate for each pair and side,
+// the executions older than STOCK_EXECUTIONS_MAX_AGE hours before passed time are outdated and skipped
+func (s *Service) getStockExecutedRates(items []*stockExecution, now time.Time) (map[string]*currencies.RateData, error) {
+	type aggregate struct {
+		amount decimal.Decimal
+		volume decimal.Decimal
+	}
+
+	aggregates := make(map[string]*aggregate)
+	minExecutedAt := now.Add(-time.Duration(s.getConfig().StockExecutionsMaxAge) * time.Hour)
+
+	for i, item := range items {
+		if !s.isPairExists(item.Pair) || item.Rate <= 0 || item.Volume <= 0 ||
+			(item.Side != currencies.ExchangeDirectionBuy && item.Side != currencies.ExchangeDirectionSell) ||
+			item.ExecutedAt.IsZero() || item.ExecutedAt.After(now) {
+			return nil, fmt.Errorf("%s, record %d", errorStockImportRecord, i+1)
+		}
+
+		if item.ExecutedAt.Before(minExecutedAt) {
+			continue
+		}
+
+		key := item.Pair + item.Side
+		a, ok := aggregates[key]
+		if !ok {
+			a = &aggregate{amount: decimal.Zero(), volume: decimal.Zero()}
+			aggregates[key] = a
+		}
+
+		volume := decimal.NewFromFloat(item.Volume)
+		a.amount = a.amount.Add(decimal.NewFromFloat(item.Rate).Mul(volume))
+		a.volume = a.volume.Add(volume)
+	}
+
+	res := make(map[string]*currencies.RateData, len(aggregates))
+
+	for key, a := range aggregates {
+		rd := &currencies.RateData{
+			Pair:   key[:6],
+			Source: stockSource,
+			Side:   key[6:],
+			Volume: a.volume.Float64(),
+		}
+		s.setRateDecimal(rd, a.amount.Div(a.volume))
+
+		res[key] = rd
+	}
+
+	return res, nil
+}
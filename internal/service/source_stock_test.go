@@ -1,59 +1,170 @@
 package service
 
 import (
+	"github.com/globalsign/mgo/bson"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/stretchr/testify/assert"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 )
 
 func (suite *CurrenciesratesServiceTestSuite) TestSource_getRateStock_Ok() {
-	rd, err := suite.service.getRateStock("USD", "RUB")
+	rd, err := suite.service.getRateStock("USD", "RUB", currencies.ExchangeDirectionSell, nil)
 	assert.NoError(suite.T(), err)
 	assert.Equal(suite.T(), rd.Rate, r)
+	assert.Equal(suite.T(), rd.Side, currencies.ExchangeDirectionSell)
 
-	rd, err = suite.service.getRateStock("USD", "RUB")
+	spread := &stockSpread{Pair: "USDRUB", Bid: 1, Ask: 2}
+
+	rd, err = suite.service.getRateStock("USD", "RUB", currencies.ExchangeDirectionSell, spread)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.RateDecimal, "63.985086")
+
+	rd, err = suite.service.getRateStock("USD", "RUB", currencies.ExchangeDirectionBuy, spread)
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), rd.RateDecimal, "65.924028")
+	assert.Equal(suite.T(), rd.Side, currencies.ExchangeDirectionBuy)
 }
 
-// waiting for commercial oxr app_id
-/*
-func (suite *CurrenciesratesServiceTestSuite) TestSource_SetRatesStock_Ok() {
-	// cleaning collection before test starts
-	err := suite.CleanRatesCollection(collectionRatesNameSuffixOxr)
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getStockSpreads_Invalid() {
+	suite.service.getConfig().StockBidSpread = 0.5
+	suite.service.getConfig().StockAskSpread = 0.7
+
+	err := suite.service.db.Collection(collectionNameStockSpreads).Insert(
+		&stockSpread{Pair: "USDRUB", Bid: -1, Ask: 2, UpdatedAt: time.Now()},
+		&stockSpread{Pair: "EURRUB", Bid: 1, Ask: 100, UpdatedAt: time.Now()},
+	)
 	assert.NoError(suite.T(), err)
 
-	err = suite.CleanRatesCollection(collectionRatesNameSuffixStock)
+	spreads, err := suite.service.getStockSpreads()
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), spreads["USDRUB"].Bid, 0.5)
+	assert.Equal(suite.T(), spreads["USDRUB"].Ask, float64(2))
+	assert.Equal(suite.T(), spreads["EURRUB"].Bid, float64(1))
+	assert.Equal(suite.T(), spreads["EURRUB"].Ask, 0.7)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_importStockExecutions_Csv() {
+	dir, err := ioutil.TempDir("", "stock")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(dir)
+
+	executedAt := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	outdatedAt := time.Now().AddDate(0, 0, -2).UTC().Format(time.RFC3339)
+
+	path := filepath.Join(dir, "executions.csv")
+	data := strings.Join([]string{
+		"pair,side,rate,volume,executed_at",
+		"USDRUB,buy,64,1000," + executedAt,
+		"USDRUB,buy,65,3000," + executedAt,
+		"USDRUB,buy,70,5000," + outdatedAt,
+		"USDRUB,sell,63.5,500," + executedAt,
+	}, "\n")
+	err = ioutil.WriteFile(path, []byte(data), 0644)
+	assert.NoError(suite.T(), err)
+
+	suite.service.getConfig().StockImportPath = path
 
-	err = suite.service.RequestRatesOxr()
+	res, imported, err := suite.service.importStockExecutions()
 	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), imported, path)
+	assert.Len(suite.T(), res, 2)
+	assert.Equal(suite.T(), res["USDRUBbuy"].RateDecimal, "64.750000")
+	assert.Equal(suite.T(), res["USDRUBbuy"].Volume, float64(4000))
+	assert.Equal(suite.T(), res["USDRUBsell"].Rate, 63.5)
 
-	err = suite.service.addCorrectionRule(pkg.RateTypeStock, 0, map[string]float64{}, "")
+	// the file is kept until the rates are saved
+	_, err = os.Stat(path)
 	assert.NoError(suite.T(), err)
 
-	err = suite.service.SetRatesStock()
+	err = suite.service.finishStockImport(imported)
 	assert.NoError(suite.T(), err)
 
-	res := &currencies.RateData{}
+	// the file is imported once
+	_, err = os.Stat(path)
+	assert.True(suite.T(), os.IsNotExist(err))
+
+	res, imported, err = suite.service.importStockExecutions()
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), imported)
+	assert.Len(suite.T(), res, 0)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_stockRateSource_Confirm() {
+	dir, err := ioutil.TempDir("", "stock")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "executions.json")
+	err = ioutil.WriteFile(path, []byte("[]"), 0644)
+	assert.NoError(suite.T(), err)
+
+	src := &stockRateSource{}
+
+	err = src.Confirm(suite.service, path)
+	assert.NoError(suite.T(), err)
 
-	for _, from := range suite.config.SettlementCurrencies {
-		for _, to := range suite.config.RatesRequestCurrencies {
+	_, err = os.Stat(path)
+	assert.True(suite.T(), os.IsNotExist(err))
+
+	// nothing to rename if the fetch didn't import a file
+	err = src.Confirm(suite.service, "")
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getStockExecutedRates_Fail() {
+	now := time.Now()
+
+	_, err := suite.service.getStockExecutedRates([]*stockExecution{
+		{Pair: "USDRUB", Side: currencies.ExchangeDirectionBuy, Rate: 64, Volume: 1},
+	}, now)
+	assert.Error(suite.T(), err)
+
+	_, err = suite.service.getStockExecutedRates([]*stockExecution{
+		{Pair: "USDRUB", Side: currencies.ExchangeDirectionBuy, Rate: 64, Volume: 1, ExecutedAt: now.Add(time.Hour)},
+	}, now)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_importStockExecutions_Fail() {
+	dir, err := ioutil.TempDir("", "stock")
+	assert.NoError(suite.T(), err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "executions.json")
+	err = ioutil.WriteFile(path, []byte(`[{"pair": "USDRUB", "side": "bla-bla", "rate": 64, "volume": 1}]`), 0644)
+	assert.NoError(suite.T(), err)
+
+	suite.service.getConfig().StockImportPath = path
+
+	_, _, err = suite.service.importStockExecutions()
+	assert.Error(suite.T(), err)
+
+	// the file with errors is kept for correction
+	_, err = os.Stat(path)
+	assert.NoError(suite.T(), err)
+
+	path = filepath.Join(dir, "executions.xml")
+	err = ioutil.WriteFile(path, []byte(""), 0644)
+	assert.NoError(suite.T(), err)
+
+	suite.service.getConfig().StockImportPath = path
+
+	_, _, err = suite.service.importStockExecutions()
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorStockImportFormat)
+}
 
-			source := stockSource
-			if from == to {
-				source = stubSource
-			}
+func (suite *CurrenciesratesServiceTestSuite) TestSource_getSideQuery() {
+	query := bson.M{"source": stockSource}
 
-			err = suite.service.getRate(pkg.RateTypeStock, from, to, bson.M{}, res)
-			assert.NoError(suite.T(), err)
-			assert.True(suite.T(), res.Rate > 0)
-			assert.Equal(suite.T(), res.Pair, from+to)
-			assert.Equal(suite.T(), res.Source, source)
+	res := suite.service.getSideQuery(currencies.RateTypeStock, currencies.ExchangeDirectionBuy, query)
+	assert.Equal(suite.T(), res["side"], bson.M{"$in": []interface{}{currencies.ExchangeDirectionBuy, nil}})
+	assert.NotContains(suite.T(), query, "side")
 
-			err = suite.service.getRate(pkg.RateTypeStock, to, from, bson.M{}, res)
-			assert.NoError(suite.T(), err)
-			assert.True(suite.T(), res.Rate > 0)
-			assert.Equal(suite.T(), res.Pair, to+from)
-			assert.Equal(suite.T(), res.Source, source)
-		}
-	}
+	res = suite.service.getSideQuery(currencies.RateTypeOxr, currencies.ExchangeDirectionBuy, query)
+	assert.NotContains(suite.T(), res, "side")
 }
-*/
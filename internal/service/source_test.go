@@ -0,0 +1,115 @@
+package service
+
+import (
+	"fmt"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_GetRateSource_Ok() {
+	src, ok := GetRateSource(cbrfSource)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), src.Name(), cbrfSource)
+	assert.Equal(suite.T(), src.RateType(), currencies.RateTypeCentralbanks)
+	assert.Equal(suite.T(), src.BaseCurrency(), cbrfTo)
+
+	src, ok = GetRateSource("cbeu")
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), src.Name(), cbeuSource)
+
+	_, ok = GetRateSource("bla-bla")
+	assert.False(suite.T(), ok)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_GetRateSourcesByType_Ok() {
+	names := GetRateSourcesNames(currencies.RateTypeCentralbanks)
+	assert.Equal(suite.T(), names, []string{cbauSource, cbcaSource, cbeuSource, cbplSource, cbrfSource, cbtrSource})
+
+	names = GetRateSourcesNames(currencies.RateTypeOxr)
+	assert.Equal(suite.T(), names, []string{oxrSource})
+
+	assert.Empty(suite.T(), GetRateSourcesByType(currencies.RateTypeCardpay))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRates_Fail() {
+	err := suite.service.RequestRates("bla-bla")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorRateSourceNotFound)
+
+	err = suite.service.RequestRatesByType("bla-bla")
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err.Error(), errorRateSourceNotFound)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_ValidateRateSource() {
+	req := &currencies.GetRateCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeCentralbanks,
+		Source:            cbtrSource,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+	}
+	assert.NoError(suite.T(), suite.service.validateReq(req))
+
+	req.Source = "bla-bla"
+	assert.Error(suite.T(), suite.service.validateReq(req))
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_validateCentralbankSource() {
+	req := &currencies.GetRateCurrentCommonRequest{
+		From:              "USD",
+		To:                "RUB",
+		RateType:          currencies.RateTypeCentralbanks,
+		Source:            cbrfSource,
+		ExchangeDirection: currencies.ExchangeDirectionSell,
+	}
+	assert.NoError(suite.T(), suite.service.validateReq(req))
+
+	req.Source = oxrSource
+	assert.Error(suite.T(), suite.service.validateReq(req))
+
+	// the rates of other types are requested without source
+	req.Source = ""
+	assert.NoError(suite.T(), suite.service.validateReq(req))
+}
+
+type confirmedTestRateSource struct {
+	RateSource
+	fetched   int
+	confirmed []string
+}
+
+func (r *confirmedTestRateSource) FetchConfirmed(s *Service) ([]interface{}, string, error) {
+	rates, err := r.Fetch(s)
+	r.fetched++
+	return rates, fmt.Sprintf("ref%d", r.fetched), err
+}
+
+func (r *confirmedTestRateSource) Confirm(s *Service, ref string) error {
+	r.confirmed = append(r.confirmed, ref)
+	return nil
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSource_RequestRates_ConfirmQuarantined() {
+	name := "CONFIRMTEST"
+	rate := r
+
+	src := &confirmedTestRateSource{
+		RateSource: NewRateSource(name, currencies.RateTypeOxr, "", func(s *Service) ([]interface{}, error) {
+			return []interface{}{&currencies.RateData{Pair: "USDRUB", Rate: rate, Source: name, Volume: 1}}, nil
+		}),
+	}
+	RegisterRateSource(src)
+	defer delete(rateSources, name)
+
+	err := suite.service.RequestRates(name)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), src.confirmed, []string{"ref1"})
+
+	// the quarantined rates are saved on approve, so the source is confirmed too
+	rate = r * 1.2
+	err = suite.service.RequestRates(name)
+	assert.Error(suite.T(), err)
+	assert.Equal(suite.T(), err, errRatesQuarantined)
+	assert.Equal(suite.T(), src.confirmed, []string{"ref1", "ref2"})
+}
@@ -0,0 +1,239 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/ProtocolONE/rabbitmq/pkg"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/streadway/amqp"
+	"go.uber.org/zap"
+)
+
+const (
+	// number of updates kept for a subscriber that is slower than the rates are saved
+	ratesSubscriberBufferSize = 100
+
+	// max number of rates in one update, the larger batches, e.g. of backfill, are sent with several updates
+	ratesUpdateMaxRates = 500
+
+	// fanout exchange all processes publish the saved rates to, each process consumes it with own queue
+	ratesUpdatesTopic = "rates_updates"
+
+	errorRatesUpdateSend          = "rates update send failed"
+	errorRatesUpdateDropped       = "rates update dropped for slow subscriber"
+	errorRatesUpdatePublish       = "rates update publish to centrifugo failed"
+	errorRatesUpdatesBrokerInit   = "rates updates broker init failed"
+	errorRatesUpdatesSubscribe    = "rates updates subscribe failed"
+	errorRatesUpdateBrokerPublish = "rates update publish to broker failed"
+)
+
+// ratesSubscriber - subscriber of SubscribeRates stream, empty filters match all rate types and sources
+type ratesSubscriber struct {
+	rateTypes map[string]bool
+	sources   map[string]bool
+	updates   chan *currencies.RatesUpdate
+}
+
+// SubscribeRates - sends each newly stored batch of rates of requested rate types and sources to the stream
+// until the subscriber disconnects
+func (s *Service) SubscribeRates(
+	ctx context.Context,
+	req *currencies.SubscribeRatesRequest,
+	stream currencies.CurrencyRatesService_SubscribeRatesStream,
+) error {
+	if err := s.validateReq(req); err != nil {
+		zap.S().Errorw(errorDbReqInvalid, "error", err, "req", req)
+		return err
+	}
+
+	sub := s.addRatesSubscriber(req)
+	defer s.removeRatesSubscriber(sub)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case update := <-sub.updates:
+			if err := stream.Send(update); err != nil {
+				zap.S().Errorw(errorRatesUpdateSend, "error", err, "rateType", update.RateType, "source", update.Source)
+				return err
+			}
+		}
+	}
+}
+
+func (s *Service) addRatesSubscriber(req *currencies.SubscribeRatesRequest) *ratesSubscriber {
+	sub := &ratesSubscriber{
+		rateTypes: make(map[string]bool, len(req.RateTypes)),
+		sources:   make(map[string]bool, len(req.Sources)),
+		updates:   make(chan *currencies.RatesUpdate, ratesSubscriberBufferSize),
+	}
+	for _, rateType := range req.RateTypes {
+		sub.rateTypes[rateType] = true
+	}
+	for _, source := range req.Sources {
+		sub.sources[source] = true
+	}
+
+	s.ratesSubscribersMx.Lock()
+	s.ratesSubscribers[sub] = true
+	s.ratesSubscribersMx.Unlock()
+
+	return sub
+}
+
+func (s *Service) removeRatesSubscriber(sub *ratesSubscriber) {
+	s.ratesSubscribersMx.Lock()
+	delete(s.ratesSubscribers, sub)
+	s.ratesSubscribersMx.Unlock()
+}
+
+// InitRatesUpdatesPublisher - connects to the broker to publish the rates saved by one-off rates requests,
+// so the SubscribeRates streams of the running service get them
+func (s *Service) InitRatesUpdatesPublisher() error {
+	if !s.getConfig().RatesUpdatesEnabled {
+		return nil
+	}
+	return s.initRatesUpdatesBroker(false)
+}
+
+// initRatesUpdatesBroker - the saved rates are published to the fanout exchange by every process that saves them:
+// the scheduler, the Cardpay consumer, the one-off requests and other replicas. Each process that serves
+// SubscribeRates consumes the exchange with own exclusive queue, that is removed when the process stops
+func (s *Service) initRatesUpdatesBroker(subscribe bool) error {
+	broker, err := rabbitmq.NewBroker(s.getConfig().BrokerAddress)
+	if err != nil {
+		zap.S().Errorw(errorRatesUpdatesBrokerInit, "error", err, "topic", ratesUpdatesTopic)
+		return err
+	}
+
+	broker.Opts.ExchangeOpts.Name = ratesUpdatesTopic
+	broker.Opts.ExchangeOpts.Kind = amqp.ExchangeFanout
+	broker.Opts.QueueOpts.Name = ratesUpdatesTopic + "." + bson.NewObjectId().Hex()
+	broker.Opts.QueueOpts.Opts = rabbitmq.Opts{
+		rabbitmq.OptDurable:    false,
+		rabbitmq.OptAutoDelete: true,
+		rabbitmq.OptExclusive:  true,
+	}
+	broker.Opts.ConsumeOpts.Opts = rabbitmq.Opts{rabbitmq.OptAutoAck: true}
+
+	if subscribe {
+		err = broker.RegisterSubscriber(ratesUpdatesTopic, s.processRatesUpdate)
+		if err != nil {
+			zap.S().Errorw(errorRatesUpdatesBrokerInit, "error", err, "topic", ratesUpdatesTopic)
+			return err
+		}
+
+		go func() {
+			if err := broker.Subscribe(nil); err != nil {
+				zap.S().Errorw(errorRatesUpdatesSubscribe, "error", err)
+				s.sendCentrifugoMessage(errorRatesUpdatesSubscribe, err)
+			}
+		}()
+	}
+
+	s.ratesUpdatesMx.Lock()
+	s.ratesUpdatesBroker = broker
+	s.ratesUpdatesMx.Unlock()
+
+	return nil
+}
+
+// processRatesUpdate - sends the rates saved by any process to the subscribers of this process
+func (s *Service) processRatesUpdate(update *currencies.RatesUpdate, d amqp.Delivery) error {
+	s.sendRatesUpdate(update)
+	return nil
+}
+
+// publishRatesUpdate - sends saved rates grouped by source to the broker and to the centrifugo channel,
+// the subscribers get them from the broker. The rates of a source are split into updates of ratesUpdateMaxRates
+func (s *Service) publishRatesUpdate(rateType string, rates []interface{}) {
+	var list []*currencies.RatesUpdate
+	updates := make(map[string]*currencies.RatesUpdate)
+
+	for _, item := range rates {
+		rd, ok := item.(*currencies.RateData)
+		if !ok {
+			continue
+		}
+
+		update, ok := updates[rd.Source]
+		if !ok || len(update.Rates) >= ratesUpdateMaxRates {
+			update = &currencies.RatesUpdate{
+				RateType:  rateType,
+				Source:    rd.Source,
+				CreatedAt: ptypes.TimestampNow(),
+			}
+			updates[rd.Source] = update
+			list = append(list, update)
+		}
+		update.Rates = append(update.Rates, rd)
+	}
+
+	for _, update := range list {
+		s.sendBrokerRatesUpdate(update)
+		s.sendCentrifugoRatesUpdate(update)
+	}
+}
+
+// sendBrokerRatesUpdate - publishes the update to the broker, the update is sent to the subscribers
+// of this process directly if there is no broker or it's unavailable.
+// The lock guards the broker pointer only, so the saving of rates doesn't wait for other publishes
+func (s *Service) sendBrokerRatesUpdate(update *currencies.RatesUpdate) {
+	s.ratesUpdatesMx.Lock()
+	broker := s.ratesUpdatesBroker
+	s.ratesUpdatesMx.Unlock()
+
+	if broker == nil {
+		s.sendRatesUpdate(update)
+		return
+	}
+
+	if err := broker.Publish(ratesUpdatesTopic, update, amqp.Table{}); err != nil {
+		zap.S().Errorw(errorRatesUpdateBrokerPublish, "error", err, "rateType", update.RateType, "source", update.Source)
+		s.sendRatesUpdate(update)
+	}
+}
+
+// sendRatesUpdate - sends own copy of the update to each matching subscriber, the streams marshal the updates
+// concurrently and the marshaling caches the size in the message
+func (s *Service) sendRatesUpdate(update *currencies.RatesUpdate) {
+	s.ratesSubscribersMx.RLock()
+	defer s.ratesSubscribersMx.RUnlock()
+
+	for sub := range s.ratesSubscribers {
+		if len(sub.rateTypes) > 0 && !sub.rateTypes[update.RateType] {
+			continue
+		}
+		if len(sub.sources) > 0 && !sub.sources[update.Source] {
+			continue
+		}
+
+		// saving of the rates is never blocked by a subscriber
+		select {
+		case sub.updates <- proto.Clone(update).(*currencies.RatesUpdate):
+		default:
+			zap.S().Warnw(errorRatesUpdateDropped, "rateType", update.RateType, "source", update.Source)
+		}
+	}
+}
+
+func (s *Service) sendCentrifugoRatesUpdate(update *currencies.RatesUpdate) {
+	if s.getConfig().CentrifugoRatesChannel == "" {
+		return
+	}
+
+	b, err := json.Marshal(update)
+	if err != nil {
+		zap.S().Errorw(errorRatesUpdatePublish, "error", err, "rateType", update.RateType, "source", update.Source)
+		return
+	}
+
+	if err = s.centrifugoClient.Publish(context.Background(), s.getConfig().CentrifugoRatesChannel, b); err != nil {
+		zap.S().Errorw(errorRatesUpdatePublish, "error", err, "rateType", update.RateType, "source", update.Source)
+	}
+}
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
+	"github.com/streadway/amqp"
+	"github.com/stretchr/testify/assert"
+	"time"
+)
+
+type ratesStreamMock struct {
+	ctx     context.Context
+	updates chan *currencies.RatesUpdate
+}
+
+func (m *ratesStreamMock) Context() context.Context {
+	return m.ctx
+}
+
+func (m *ratesStreamMock) SendMsg(interface{}) error {
+	return nil
+}
+
+func (m *ratesStreamMock) RecvMsg(interface{}) error {
+	return nil
+}
+
+func (m *ratesStreamMock) Close() error {
+	return nil
+}
+
+func (m *ratesStreamMock) Send(update *currencies.RatesUpdate) error {
+	m.updates <- update
+	return nil
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSubscription_publishRatesUpdate() {
+	sub := suite.service.addRatesSubscriber(&currencies.SubscribeRatesRequest{
+		RateTypes: []string{currencies.RateTypeOxr},
+		Sources:   []string{oxrSource},
+	})
+	defer suite.service.removeRatesSubscriber(sub)
+
+	rates := []interface{}{
+		&currencies.RateData{Pair: "USDEUR", Rate: 0.9, Source: oxrSource, Volume: 1},
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: oxrSource, Volume: 1},
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: "TEST", Volume: 1},
+	}
+	err := suite.service.saveRates(collectionRatesNameSuffixOxr, rates)
+	assert.NoError(suite.T(), err)
+
+	assert.Len(suite.T(), sub.updates, 1)
+	update := <-sub.updates
+	assert.Equal(suite.T(), update.RateType, currencies.RateTypeOxr)
+	assert.Equal(suite.T(), update.Source, oxrSource)
+	assert.Len(suite.T(), update.Rates, 2)
+
+	err = suite.service.saveRates(collectionRatesNameSuffixCentralbanks, rates)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), sub.updates, 0)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSubscription_publishRatesUpdate_Chunks() {
+	sub1 := suite.service.addRatesSubscriber(&currencies.SubscribeRatesRequest{})
+	defer suite.service.removeRatesSubscriber(sub1)
+	sub2 := suite.service.addRatesSubscriber(&currencies.SubscribeRatesRequest{})
+	defer suite.service.removeRatesSubscriber(sub2)
+
+	var rates []interface{}
+	for i := 0; i <= ratesUpdateMaxRates; i++ {
+		rates = append(rates, &currencies.RateData{Pair: "USDRUB", Rate: r, Source: oxrSource, Volume: 1})
+	}
+	suite.service.publishRatesUpdate(currencies.RateTypeOxr, rates)
+
+	assert.Len(suite.T(), sub1.updates, 2)
+	assert.Len(suite.T(), sub2.updates, 2)
+
+	update1 := <-sub1.updates
+	update2 := <-sub2.updates
+	assert.Len(suite.T(), update1.Rates, ratesUpdateMaxRates)
+	assert.Len(suite.T(), update2.Rates, ratesUpdateMaxRates)
+	// each subscriber gets own copy of the update
+	assert.False(suite.T(), update1 == update2)
+	assert.False(suite.T(), update1.Rates[0] == update2.Rates[0])
+
+	assert.Len(suite.T(), (<-sub1.updates).Rates, 1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSubscription_processRatesUpdate() {
+	sub := suite.service.addRatesSubscriber(&currencies.SubscribeRatesRequest{RateTypes: []string{currencies.RateTypeOxr}})
+	defer suite.service.removeRatesSubscriber(sub)
+
+	// the rates saved by other processes come from the broker
+	update := &currencies.RatesUpdate{
+		RateType: currencies.RateTypeOxr,
+		Source:   oxrSource,
+		Rates:    []*currencies.RateData{{Pair: "USDRUB", Rate: r, Source: oxrSource, Volume: 1}},
+	}
+	err := suite.service.processRatesUpdate(update, amqp.Delivery{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), sub.updates, 1)
+
+	update = &currencies.RatesUpdate{RateType: currencies.RateTypeCentralbanks, Source: cbrfSource}
+	err = suite.service.processRatesUpdate(update, amqp.Delivery{})
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), sub.updates, 1)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSubscription_SubscribeRates() {
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &ratesStreamMock{ctx: ctx, updates: make(chan *currencies.RatesUpdate, 1)}
+
+	done := make(chan error)
+	go func() {
+		done <- suite.service.SubscribeRates(ctx, &currencies.SubscribeRatesRequest{}, stream)
+	}()
+
+	assert.Eventually(suite.T(), func() bool {
+		suite.service.ratesSubscribersMx.RLock()
+		defer suite.service.ratesSubscribersMx.RUnlock()
+		return len(suite.service.ratesSubscribers) == 1
+	}, time.Second, 10*time.Millisecond)
+
+	err := suite.service.saveRates(collectionRatesNameSuffixCentralbanks, []interface{}{
+		&currencies.RateData{Pair: "USDRUB", Rate: r, Source: cbrfSource, Volume: 1},
+	})
+	assert.NoError(suite.T(), err)
+
+	select {
+	case update := <-stream.updates:
+		assert.Equal(suite.T(), update.RateType, currencies.RateTypeCentralbanks)
+		assert.Equal(suite.T(), update.Source, cbrfSource)
+	case <-time.After(time.Second):
+		assert.Fail(suite.T(), "rates update is not sent")
+	}
+
+	cancel()
+	assert.NoError(suite.T(), <-done)
+	assert.Len(suite.T(), suite.service.ratesSubscribers, 0)
+}
+
+func (suite *CurrenciesratesServiceTestSuite) TestSubscription_SubscribeRates_Fail() {
+	req := &currencies.SubscribeRatesRequest{RateTypes: []string{"bla-bla"}}
+	err := suite.service.SubscribeRates(context.TODO(), req, &ratesStreamMock{})
+	assert.Error(suite.T(), err)
+}
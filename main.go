@@ -18,11 +18,14 @@ import (
 	"github.com/micro/go-plugins/wrapper/monitoring/prometheus"
 	"github.com/paysuper/paysuper-currencies/config"
 	"github.com/paysuper/paysuper-currencies/internal/service"
+	currencies "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 	"github.com/paysuper/paysuper-database-mongo"
-	currencies "github.com/paysuper/paysuper-proto/go/currenciespb"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
-	"golang.org/x/sync/errgroup"
+)
+
+const (
+	backfillDateLayout = "2006-01-02"
 )
 
 func main() {
@@ -58,65 +61,57 @@ func main() {
 		logger.Fatal("Can`t create currency rates service", zap.Error(err))
 	}
 
-	var source string
-	flag.StringVar(&source, "source", "", "rates source")
+	var (
+		source   string
+		backfill bool
+		from     string
+		to       string
+	)
+	flag.StringVar(&source, "source", "", "rates source name or rate type to request rates of all its sources")
+	flag.BoolVar(&backfill, "backfill", false, "request historical rates of the source for the dates range")
+	flag.StringVar(&from, "from", "", "first date of the backfill range, in YYYY-MM-DD format")
+	flag.StringVar(&to, "to", "", "last date of the backfill range, in YYYY-MM-DD format, today by default")
 	flag.Parse()
 
 	if source != "" {
-		logger.Info("Updating currency rates from " + source)
+		// the rates are saved without the broker, only the subscribers of the running service miss them
+		if err := cs.InitRatesUpdatesPublisher(); err != nil {
+			logger.Error("Rates updates publisher init failed", zap.Error(err))
+		}
+	}
 
-		defer db.Close()
+	if source != "" && backfill {
+		logger.Info("Backfilling currency rates from " + source)
 
-		g := errgroup.Group{}
+		defer db.Close()
 
-		var err error
+		dateFrom, err := time.Parse(backfillDateLayout, from)
+		if err != nil {
+			logger.Fatal("Backfill from date invalid", zap.Error(err))
+		}
 
-		switch source {
-		case "oxr":
-			err = cs.RequestRatesOxr()
-			if err == nil {
-				g.Go(func() error {
-					return cs.SetRatesPaysuper()
-				})
-				g.Go(func() error {
-					return cs.SetRatesStock()
-				})
+		dateTo := time.Now()
+		if to != "" {
+			dateTo, err = time.Parse(backfillDateLayout, to)
+			if err != nil {
+				logger.Fatal("Backfill to date invalid", zap.Error(err))
 			}
-		case "paysuper":
-			g.Go(func() error {
-				return cs.SetRatesPaysuper()
-			})
-		case "centralbanks":
-			g.Go(func() error {
-				return cs.RequestRatesCbrf()
-			})
-			g.Go(func() error {
-				return cs.RequestRatesCbeu()
-			})
-			g.Go(func() error {
-				return cs.RequestRatesCbca()
-			})
-			g.Go(func() error {
-				return cs.RequestRatesCbpl()
-			})
-			g.Go(func() error {
-				return cs.RequestRatesCbau()
-			})
-			g.Go(func() error {
-				return cs.RequestRatesCbtr()
-			})
-		case "stock":
-			g.Go(func() error {
-				return cs.SetRatesStock()
-			})
-		default:
-			logger.Fatal("Source is unknown, exiting")
 		}
 
-		if err == nil {
-			err = g.Wait()
+		err = cs.BackfillRates(source, dateFrom, dateTo)
+		if err != nil {
+			logger.Fatal("Backfilling currency rates error", zap.Error(err))
 		}
 
+		return
+	}
+
+	if source != "" {
+		logger.Info("Updating currency rates from " + source)
+
+		defer db.Close()
+
+		err := cs.RequestRatesBySourceOrType(source)
 		if err != nil {
 			logger.Fatal("Updating currency rates error", zap.Error(err))
 		}
@@ -151,6 +146,11 @@ func main() {
 		Handler: router,
 	}
 
+	restServer := &http.Server{
+		Addr:    fmt.Sprintf(":%d", cfg.RestPort),
+		Handler: cs.NewRestHandler(),
+	}
+
 	var ms micro.Service
 	options := []micro.Option{
 		micro.Name(currencies.ServiceName),
@@ -163,6 +163,14 @@ func main() {
 					logger.Error("Metrics and health check listen failed", zap.Error(err))
 				}
 			}()
+			if cfg.RestPort > 0 {
+				go func() {
+					logger.Info("REST gateway listening", zap.Int("port", cfg.RestPort))
+					if err := restServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+						logger.Error("REST gateway listen failed", zap.Error(err))
+					}
+				}()
+			}
 			return nil
 		}),
 		micro.AfterStop(func() error {
@@ -174,6 +182,16 @@ func main() {
 			}
 			logger.Info("Http server stopped")
 
+			if cfg.RestPort > 0 {
+				if err := restServer.Shutdown(ctx); err != nil {
+					logger.Fatal("REST gateway shutdown failed", zap.Error(err))
+				}
+				logger.Info("REST gateway stopped")
+			}
+
+			cs.Stop()
+			logger.Info("Service background processes stopped")
+
 			db.Close()
 			logger.Info("Db closed")
 
@@ -1,7 +1,7 @@
 package pkg
 
 import (
-	"github.com/paysuper/paysuper-proto/go/currenciespb"
+	currenciespb "github.com/paysuper/paysuper-currencies/pkg/grpc/proto"
 )
 
 const (
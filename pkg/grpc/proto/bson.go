@@ -0,0 +1,218 @@
+package proto
+
+import (
+	"errors"
+	"github.com/globalsign/mgo/bson"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"time"
+)
+
+const (
+	errorInvalidObjectId = "invalid bson object id"
+)
+
+// MgoRateData - mongo representation of RateData, the dates are stored as dates to be queried by ranges
+type MgoRateData struct {
+	Id            bson.ObjectId `bson:"_id"`
+	CreatedAt     time.Time     `bson:"created_at"`
+	Pair          string        `bson:"pair"`
+	Rate          float64       `bson:"rate"`
+	Source        string        `bson:"source"`
+	Volume        float64       `bson:"volume"`
+	EffectiveDate *time.Time    `bson:"effective_date,omitempty"`
+	RateDecimal   string        `bson:"rate_decimal"`
+	Side          string        `bson:"side,omitempty"`
+}
+
+// MgoCorrectionRule - mongo representation of CorrectionRule, a rule without end of validity is stored with null valid_to
+type MgoCorrectionRule struct {
+	Id                bson.ObjectId      `bson:"_id"`
+	RateType          string             `bson:"rate_type"`
+	ExchangeDirection string             `bson:"exchange_direction"`
+	CommonCorrection  float64            `bson:"common_correction"`
+	PairCorrection    map[string]float64 `bson:"pair_correction"`
+	CreatedAt         time.Time          `bson:"created_at"`
+	MerchantId        string             `bson:"merchant_id"`
+	ValidFrom         time.Time          `bson:"valid_from"`
+	ValidTo           *time.Time         `bson:"valid_to"`
+	Kind              string             `bson:"kind"`
+	CommonSpread      float64            `bson:"common_spread"`
+	PairSpread        map[string]float64 `bson:"pair_spread"`
+	Tiers             []*CorrectionTier  `bson:"tiers"`
+}
+
+func (m *RateData) GetBSON() (interface{}, error) {
+	st := &MgoRateData{
+		Pair:        m.Pair,
+		Rate:        m.Rate,
+		Source:      m.Source,
+		Volume:      m.Volume,
+		RateDecimal: m.RateDecimal,
+		Side:        m.Side,
+	}
+
+	id, err := getObjectId(m.Id)
+	if err != nil {
+		return nil, err
+	}
+	st.Id = id
+
+	if m.CreatedAt != nil {
+		st.CreatedAt, err = ptypes.Timestamp(m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	st.EffectiveDate, err = getTimePtr(m.EffectiveDate)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func (m *RateData) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoRateData)
+	err := raw.Unmarshal(decoded)
+	if err != nil {
+		return err
+	}
+
+	m.Id = getObjectIdHex(decoded.Id)
+	m.Pair = decoded.Pair
+	m.Rate = decoded.Rate
+	m.Source = decoded.Source
+	m.Volume = decoded.Volume
+	m.RateDecimal = decoded.RateDecimal
+	m.Side = decoded.Side
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.EffectiveDate, err = getTimestampPtr(decoded.EffectiveDate)
+	return err
+}
+
+func (m *CorrectionRule) GetBSON() (interface{}, error) {
+	st := &MgoCorrectionRule{
+		RateType:          m.RateType,
+		ExchangeDirection: m.ExchangeDirection,
+		CommonCorrection:  m.CommonCorrection,
+		PairCorrection:    m.PairCorrection,
+		MerchantId:        m.MerchantId,
+		Kind:              m.Kind,
+		CommonSpread:      m.CommonSpread,
+		PairSpread:        m.PairSpread,
+		Tiers:             m.Tiers,
+	}
+
+	id, err := getObjectId(m.Id)
+	if err != nil {
+		return nil, err
+	}
+	st.Id = id
+
+	if m.CreatedAt != nil {
+		st.CreatedAt, err = ptypes.Timestamp(m.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		st.CreatedAt = time.Now()
+	}
+
+	if m.ValidFrom != nil {
+		st.ValidFrom, err = ptypes.Timestamp(m.ValidFrom)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		st.ValidFrom = st.CreatedAt
+	}
+
+	st.ValidTo, err = getTimePtr(m.ValidTo)
+	if err != nil {
+		return nil, err
+	}
+
+	return st, nil
+}
+
+func (m *CorrectionRule) SetBSON(raw bson.Raw) error {
+	decoded := new(MgoCorrectionRule)
+	err := raw.Unmarshal(decoded)
+	if err != nil {
+		return err
+	}
+
+	m.Id = getObjectIdHex(decoded.Id)
+	m.RateType = decoded.RateType
+	m.ExchangeDirection = decoded.ExchangeDirection
+	m.CommonCorrection = decoded.CommonCorrection
+	m.PairCorrection = decoded.PairCorrection
+	m.MerchantId = decoded.MerchantId
+	m.Kind = decoded.Kind
+	m.CommonSpread = decoded.CommonSpread
+	m.PairSpread = decoded.PairSpread
+	m.Tiers = decoded.Tiers
+
+	m.CreatedAt, err = ptypes.TimestampProto(decoded.CreatedAt)
+	if err != nil {
+		return err
+	}
+
+	m.ValidFrom, err = ptypes.TimestampProto(decoded.ValidFrom)
+	if err != nil {
+		return err
+	}
+
+	m.ValidTo, err = getTimestampPtr(decoded.ValidTo)
+	return err
+}
+
+// getObjectId - returns object id of hex string, new object id for empty string
+func getObjectId(id string) (bson.ObjectId, error) {
+	if id == "" {
+		return bson.NewObjectId(), nil
+	}
+
+	if !bson.IsObjectIdHex(id) {
+		return "", errors.New(errorInvalidObjectId)
+	}
+
+	return bson.ObjectIdHex(id), nil
+}
+
+// getObjectIdHex - returns hex of object id, the ids stored as hex strings are returned as is
+func getObjectIdHex(id bson.ObjectId) string {
+	if id.Valid() {
+		return id.Hex()
+	}
+	return string(id)
+}
+
+func getTimePtr(ts *timestamp.Timestamp) (*time.Time, error) {
+	if ts == nil {
+		return nil, nil
+	}
+
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func getTimestampPtr(t *time.Time) (*timestamp.Timestamp, error) {
+	if t == nil {
+		return nil, nil
+	}
+	return ptypes.TimestampProto(*t)
+}
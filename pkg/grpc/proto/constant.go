@@ -0,0 +1,15 @@
+package proto
+
+const (
+	ServiceName = "p1paycurrencies"
+	Version     = "latest"
+
+	RateTypeOxr          = "oxr"
+	RateTypeCentralbanks = "centralbanks"
+	RateTypePaysuper     = "paysuper"
+	RateTypeStock        = "stock"
+	RateTypeCardpay      = "cardpay"
+
+	ExchangeDirectionSell = "sell"
+	ExchangeDirectionBuy  = "buy"
+)
@@ -0,0 +1,23 @@
+package proto
+
+import "regexp"
+
+var pairRegexp = regexp.MustCompile("^[A-Z]{6}$")
+
+// GetCorrectionValue - returns correction of the pair, common correction for empty pair or pair without own correction,
+// zero for invalid pair
+func (m *CorrectionRule) GetCorrectionValue(pair string) float64 {
+	if pair == "" {
+		return m.CommonCorrection
+	}
+
+	if !pairRegexp.MatchString(pair) {
+		return 0
+	}
+
+	if val, ok := m.PairCorrection[pair]; ok {
+		return val
+	}
+
+	return m.CommonCorrection
+}
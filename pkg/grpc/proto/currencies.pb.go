@@ -1,2947 +1,5535 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.24.0
-// 	protoc        (unknown)
 // source: pkg/grpc/proto/currencies.proto
 
 package proto
 
 import (
 	context "context"
+	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	timestamp "github.com/golang/protobuf/ptypes/timestamp"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
-	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	reflect "reflect"
-	sync "sync"
+	math "math"
 )
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
 
-// This is a compile-time assertion that a sufficiently up-to-date version
-// of the legacy proto package is being used.
-const _ = proto.ProtoPackageIsVersion4
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type GetRateCurrentCommonRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,5,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection    string   `protobuf:"bytes,5,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *GetRateCurrentCommonRequest) Reset() {
-	*x = GetRateCurrentCommonRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *GetRateCurrentCommonRequest) Reset()         { *m = GetRateCurrentCommonRequest{} }
+func (m *GetRateCurrentCommonRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRateCurrentCommonRequest) ProtoMessage()    {}
+func (*GetRateCurrentCommonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{0}
 }
 
-func (x *GetRateCurrentCommonRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *GetRateCurrentCommonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRateCurrentCommonRequest.Unmarshal(m, b)
 }
-
-func (*GetRateCurrentCommonRequest) ProtoMessage() {}
-
-func (x *GetRateCurrentCommonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *GetRateCurrentCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRateCurrentCommonRequest.Marshal(b, m, deterministic)
 }
-
-// Deprecated: Use GetRateCurrentCommonRequest.ProtoReflect.Descriptor instead.
-func (*GetRateCurrentCommonRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{0}
+func (m *GetRateCurrentCommonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRateCurrentCommonRequest.Merge(m, src)
+}
+func (m *GetRateCurrentCommonRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRateCurrentCommonRequest.Size(m)
 }
+func (m *GetRateCurrentCommonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRateCurrentCommonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRateCurrentCommonRequest proto.InternalMessageInfo
 
-func (x *GetRateCurrentCommonRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *GetRateCurrentCommonRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *GetRateCurrentCommonRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *GetRateCurrentCommonRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *GetRateCurrentCommonRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *GetRateCurrentCommonRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *GetRateCurrentCommonRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *GetRateCurrentCommonRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *GetRateCurrentCommonRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *GetRateCurrentCommonRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
 type GetRateByDateCommonRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	//@inject_tag: validate:"required"
-	Datetime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Datetime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty" validate:"required"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection    string   `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *GetRateByDateCommonRequest) Reset() {
-	*x = GetRateByDateCommonRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *GetRateByDateCommonRequest) Reset()         { *m = GetRateByDateCommonRequest{} }
+func (m *GetRateByDateCommonRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRateByDateCommonRequest) ProtoMessage()    {}
+func (*GetRateByDateCommonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{1}
 }
 
-func (x *GetRateByDateCommonRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *GetRateByDateCommonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRateByDateCommonRequest.Unmarshal(m, b)
 }
-
-func (*GetRateByDateCommonRequest) ProtoMessage() {}
-
-func (x *GetRateByDateCommonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *GetRateByDateCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRateByDateCommonRequest.Marshal(b, m, deterministic)
 }
-
-// Deprecated: Use GetRateByDateCommonRequest.ProtoReflect.Descriptor instead.
-func (*GetRateByDateCommonRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{1}
+func (m *GetRateByDateCommonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRateByDateCommonRequest.Merge(m, src)
+}
+func (m *GetRateByDateCommonRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRateByDateCommonRequest.Size(m)
+}
+func (m *GetRateByDateCommonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRateByDateCommonRequest.DiscardUnknown(m)
 }
 
-func (x *GetRateByDateCommonRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+var xxx_messageInfo_GetRateByDateCommonRequest proto.InternalMessageInfo
+
+func (m *GetRateByDateCommonRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *GetRateByDateCommonRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *GetRateByDateCommonRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *GetRateByDateCommonRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *GetRateByDateCommonRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *GetRateByDateCommonRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *GetRateByDateCommonRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *GetRateByDateCommonRequest) GetDatetime() *timestamp.Timestamp {
-	if x != nil {
-		return x.Datetime
+func (m *GetRateByDateCommonRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
 	}
 	return nil
 }
 
-func (x *GetRateByDateCommonRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *GetRateByDateCommonRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
 type GetRateCurrentForMerchantRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	MerchantId string `protobuf:"bytes,5,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection    string   `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *GetRateCurrentForMerchantRequest) Reset() {
-	*x = GetRateCurrentForMerchantRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *GetRateCurrentForMerchantRequest) Reset()         { *m = GetRateCurrentForMerchantRequest{} }
+func (m *GetRateCurrentForMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRateCurrentForMerchantRequest) ProtoMessage()    {}
+func (*GetRateCurrentForMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{2}
 }
 
-func (x *GetRateCurrentForMerchantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *GetRateCurrentForMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRateCurrentForMerchantRequest.Unmarshal(m, b)
 }
-
-func (*GetRateCurrentForMerchantRequest) ProtoMessage() {}
-
-func (x *GetRateCurrentForMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[2]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *GetRateCurrentForMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRateCurrentForMerchantRequest.Marshal(b, m, deterministic)
 }
-
-// Deprecated: Use GetRateCurrentForMerchantRequest.ProtoReflect.Descriptor instead.
-func (*GetRateCurrentForMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{2}
+func (m *GetRateCurrentForMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRateCurrentForMerchantRequest.Merge(m, src)
+}
+func (m *GetRateCurrentForMerchantRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRateCurrentForMerchantRequest.Size(m)
 }
+func (m *GetRateCurrentForMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRateCurrentForMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRateCurrentForMerchantRequest proto.InternalMessageInfo
 
-func (x *GetRateCurrentForMerchantRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *GetRateCurrentForMerchantRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *GetRateCurrentForMerchantRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *GetRateCurrentForMerchantRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *GetRateCurrentForMerchantRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *GetRateCurrentForMerchantRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *GetRateCurrentForMerchantRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *GetRateCurrentForMerchantRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *GetRateCurrentForMerchantRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+func (m *GetRateCurrentForMerchantRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (x *GetRateCurrentForMerchantRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *GetRateCurrentForMerchantRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
 type GetRateByDateForMerchantRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	//@inject_tag: validate:"required"
-	Datetime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Datetime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=datetime,proto3" json:"datetime,omitempty" validate:"required"`
 	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection    string   `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *GetRateByDateForMerchantRequest) Reset() {
-	*x = GetRateByDateForMerchantRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *GetRateByDateForMerchantRequest) Reset()         { *m = GetRateByDateForMerchantRequest{} }
+func (m *GetRateByDateForMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRateByDateForMerchantRequest) ProtoMessage()    {}
+func (*GetRateByDateForMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{3}
 }
 
-func (x *GetRateByDateForMerchantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *GetRateByDateForMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRateByDateForMerchantRequest.Unmarshal(m, b)
 }
-
-func (*GetRateByDateForMerchantRequest) ProtoMessage() {}
-
-func (x *GetRateByDateForMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *GetRateByDateForMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRateByDateForMerchantRequest.Marshal(b, m, deterministic)
 }
-
-// Deprecated: Use GetRateByDateForMerchantRequest.ProtoReflect.Descriptor instead.
-func (*GetRateByDateForMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{3}
+func (m *GetRateByDateForMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRateByDateForMerchantRequest.Merge(m, src)
+}
+func (m *GetRateByDateForMerchantRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRateByDateForMerchantRequest.Size(m)
+}
+func (m *GetRateByDateForMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRateByDateForMerchantRequest.DiscardUnknown(m)
 }
 
-func (x *GetRateByDateForMerchantRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+var xxx_messageInfo_GetRateByDateForMerchantRequest proto.InternalMessageInfo
+
+func (m *GetRateByDateForMerchantRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *GetRateByDateForMerchantRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *GetRateByDateForMerchantRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *GetRateByDateForMerchantRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *GetRateByDateForMerchantRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *GetRateByDateForMerchantRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *GetRateByDateForMerchantRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *GetRateByDateForMerchantRequest) GetDatetime() *timestamp.Timestamp {
-	if x != nil {
-		return x.Datetime
+func (m *GetRateByDateForMerchantRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
 	}
 	return nil
 }
 
-func (x *GetRateByDateForMerchantRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+func (m *GetRateByDateForMerchantRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (x *GetRateByDateForMerchantRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *GetRateByDateForMerchantRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
 type RateData struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
 	//@inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24" bson:"_id"`
 	//@inject_tag: validate:"required" json:"created_at" bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at" validate:"required" bson:"created_at"`
 	//@inject_tag: validate:"required,alpha,len=6" json:"pair" bson:"pair"
-	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Pair string `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair" validate:"required,alpha,len=6" bson:"pair"`
 	//@inject_tag: validate:"required,numeric,gt=0" json:"rate" bson:"rate"
-	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate" validate:"required,numeric,gt=0" bson:"rate"`
 	//@inject_tag: validate:"required,alpha" json:"source" bson:"source"
-	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source" validate:"required,alpha" bson:"source"`
 	//@inject_tag: validate:"numeric" json:"volume" bson:"volume"
-	Volume float64 `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+	Volume float64 `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume" validate:"numeric" bson:"volume"`
+	// cross rate calculation details, filled only for the pairs without direct quote
+	//@inject_tag: json:"cross_rate,omitempty" bson:"-"
+	CrossRate *CrossRate `protobuf:"bytes,7,opt,name=cross_rate,json=crossRate,proto3" json:"cross_rate,omitempty" bson:"-"`
+	// official date of the rate from the source, the date the rate is actual for
+	//@inject_tag: json:"effective_date" bson:"effective_date"
+	EffectiveDate *timestamp.Timestamp `protobuf:"bytes,8,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date" bson:"effective_date"`
+	// rate as fixed-point decimal string, it's used for calculations instead of the float rate
+	//@inject_tag: json:"rate_decimal" bson:"rate_decimal"
+	RateDecimal string `protobuf:"bytes,9,opt,name=rate_decimal,json=rateDecimal,proto3" json:"rate_decimal" bson:"rate_decimal"`
+	// side of stock rate, buy or sell, empty for other rate types
+	//@inject_tag: json:"side,omitempty" bson:"side,omitempty"
+	Side string `protobuf:"bytes,10,opt,name=side,proto3" json:"side,omitempty" bson:"side,omitempty"`
+	// details of the rate document and the correction rule used for the response, it's not stored
+	//@inject_tag: json:"provenance,omitempty" bson:"-"
+	Provenance           *RateProvenance `protobuf:"bytes,11,opt,name=provenance,proto3" json:"provenance,omitempty" bson:"-"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32           `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RateData) Reset()         { *m = RateData{} }
+func (m *RateData) String() string { return proto.CompactTextString(m) }
+func (*RateData) ProtoMessage()    {}
+func (*RateData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{4}
 }
 
-func (x *RateData) Reset() {
-	*x = RateData{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[4]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *RateData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateData.Unmarshal(m, b)
 }
-
-func (x *RateData) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *RateData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateData.Marshal(b, m, deterministic)
 }
-
-func (*RateData) ProtoMessage() {}
-
-func (x *RateData) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[4]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *RateData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateData.Merge(m, src)
 }
-
-// Deprecated: Use RateData.ProtoReflect.Descriptor instead.
-func (*RateData) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{4}
+func (m *RateData) XXX_Size() int {
+	return xxx_messageInfo_RateData.Size(m)
 }
+func (m *RateData) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateData proto.InternalMessageInfo
 
-func (x *RateData) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *RateData) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-func (x *RateData) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (m *RateData) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-func (x *RateData) GetPair() string {
-	if x != nil {
-		return x.Pair
+func (m *RateData) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
 	return ""
 }
 
-func (x *RateData) GetRate() float64 {
-	if x != nil {
-		return x.Rate
+func (m *RateData) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
 	return 0
 }
 
-func (x *RateData) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *RateData) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *RateData) GetVolume() float64 {
-	if x != nil {
-		return x.Volume
+func (m *RateData) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
 	}
 	return 0
 }
 
-type CardpayRate struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//@inject_tag: validate:"required" json:"created_at" bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	//@inject_tag: validate:"required,alpha,len=3" json:"from" bson:"from"
-	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
-	//@inject_tag: validate:"required,alpha,len=3" json:"to" bson:"to"
-	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,numeric,gt=0" json:"rate" bson:"rate"
-	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate,omitempty"`
-	//@inject_tag: validate:"required,alpha" json:"source" bson:"source"
-	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
-	//@inject_tag: validate:"numeric" json:"volume" bson:"volume"
-	Volume float64 `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume,omitempty"`
+func (m *RateData) GetCrossRate() *CrossRate {
+	if m != nil {
+		return m.CrossRate
+	}
+	return nil
 }
 
-func (x *CardpayRate) Reset() {
-	*x = CardpayRate{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[5]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *RateData) GetEffectiveDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveDate
 	}
+	return nil
 }
 
-func (x *CardpayRate) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *RateData) GetRateDecimal() string {
+	if m != nil {
+		return m.RateDecimal
+	}
+	return ""
 }
 
-func (*CardpayRate) ProtoMessage() {}
+func (m *RateData) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
 
-func (x *CardpayRate) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *RateData) GetProvenance() *RateProvenance {
+	if m != nil {
+		return m.Provenance
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CardpayRate.ProtoReflect.Descriptor instead.
-func (*CardpayRate) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{5}
+type RateProvenance struct {
+	// id of the rate document, empty for the cross rates and the rates of the same currencies
+	//@inject_tag: json:"rate_id"
+	RateId string `protobuf:"bytes,1,opt,name=rate_id,json=rateId,proto3" json:"rate_id"`
+	// rate type of the rate document, it differs from the requested one on fallback
+	//@inject_tag: json:"rate_type"
+	RateType string `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type"`
+	//@inject_tag: json:"source"
+	Source string `protobuf:"bytes,3,opt,name=source,proto3" json:"source"`
+	//@inject_tag: json:"effective_date"
+	EffectiveDate *timestamp.Timestamp `protobuf:"bytes,4,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date"`
+	// there is no central bank rate for the pair, so OXR rate is used
+	//@inject_tag: json:"fallback"
+	Fallback bool `protobuf:"varint,5,opt,name=fallback,proto3" json:"fallback"`
+	// id of the applied correction rule, empty if there is no rule
+	//@inject_tag: json:"correction_rule_id"
+	CorrectionRuleId string `protobuf:"bytes,6,opt,name=correction_rule_id,json=correctionRuleId,proto3" json:"correction_rule_id"`
+	// scope of the applied correction rule, merchant or common
+	//@inject_tag: json:"correction_rule_scope"
+	CorrectionRuleScope  string   `protobuf:"bytes,7,opt,name=correction_rule_scope,json=correctionRuleScope,proto3" json:"correction_rule_scope"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CardpayRate) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+func (m *RateProvenance) Reset()         { *m = RateProvenance{} }
+func (m *RateProvenance) String() string { return proto.CompactTextString(m) }
+func (*RateProvenance) ProtoMessage()    {}
+func (*RateProvenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{5}
 }
 
-func (x *CardpayRate) GetFrom() string {
-	if x != nil {
-		return x.From
-	}
-	return ""
+func (m *RateProvenance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateProvenance.Unmarshal(m, b)
+}
+func (m *RateProvenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateProvenance.Marshal(b, m, deterministic)
+}
+func (m *RateProvenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateProvenance.Merge(m, src)
+}
+func (m *RateProvenance) XXX_Size() int {
+	return xxx_messageInfo_RateProvenance.Size(m)
+}
+func (m *RateProvenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateProvenance.DiscardUnknown(m)
 }
 
-func (x *CardpayRate) GetTo() string {
-	if x != nil {
-		return x.To
+var xxx_messageInfo_RateProvenance proto.InternalMessageInfo
+
+func (m *RateProvenance) GetRateId() string {
+	if m != nil {
+		return m.RateId
 	}
 	return ""
 }
 
-func (x *CardpayRate) GetRate() float64 {
-	if x != nil {
-		return x.Rate
+func (m *RateProvenance) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
-	return 0
+	return ""
 }
 
-func (x *CardpayRate) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *RateProvenance) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *CardpayRate) GetVolume() float64 {
-	if x != nil {
-		return x.Volume
+func (m *RateProvenance) GetEffectiveDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveDate
 	}
-	return 0
-}
-
-type EmptyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return nil
 }
 
-func (x *EmptyResponse) Reset() {
-	*x = EmptyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *RateProvenance) GetFallback() bool {
+	if m != nil {
+		return m.Fallback
 	}
+	return false
 }
 
-func (x *EmptyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *RateProvenance) GetCorrectionRuleId() string {
+	if m != nil {
+		return m.CorrectionRuleId
+	}
+	return ""
 }
 
-func (*EmptyResponse) ProtoMessage() {}
-
-func (x *EmptyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[6]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *RateProvenance) GetCorrectionRuleScope() string {
+	if m != nil {
+		return m.CorrectionRuleScope
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EmptyResponse.ProtoReflect.Descriptor instead.
-func (*EmptyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{6}
+type CrossRate struct {
+	//@inject_tag: json:"pivot"
+	Pivot string `protobuf:"bytes,1,opt,name=pivot,proto3" json:"pivot"`
+	//@inject_tag: json:"legs"
+	Legs                 []*RateLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32      `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-type EmptyRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *CrossRate) Reset()         { *m = CrossRate{} }
+func (m *CrossRate) String() string { return proto.CompactTextString(m) }
+func (*CrossRate) ProtoMessage()    {}
+func (*CrossRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{6}
 }
 
-func (x *EmptyRequest) Reset() {
-	*x = EmptyRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *CrossRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CrossRate.Unmarshal(m, b)
 }
-
-func (x *EmptyRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *CrossRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CrossRate.Marshal(b, m, deterministic)
+}
+func (m *CrossRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CrossRate.Merge(m, src)
+}
+func (m *CrossRate) XXX_Size() int {
+	return xxx_messageInfo_CrossRate.Size(m)
+}
+func (m *CrossRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CrossRate.DiscardUnknown(m)
 }
 
-func (*EmptyRequest) ProtoMessage() {}
+var xxx_messageInfo_CrossRate proto.InternalMessageInfo
 
-func (x *EmptyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *CrossRate) GetPivot() string {
+	if m != nil {
+		return m.Pivot
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use EmptyRequest.ProtoReflect.Descriptor instead.
-func (*EmptyRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{7}
+func (m *CrossRate) GetLegs() []*RateLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
 }
 
-type CorrectionCorridor struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//@inject_tag: validate:"required,numeric,gte=0,lte=1"
-	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty"`
+type RateLeg struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"pair"
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair"`
+	//@inject_tag: json:"rate"
+	Rate float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate"`
+	//@inject_tag: json:"source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"effective_date"
+	EffectiveDate        *timestamp.Timestamp `protobuf:"bytes,6,opt,name=effective_date,json=effectiveDate,proto3" json:"effective_date"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CorrectionCorridor) Reset() {
-	*x = CorrectionCorridor{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *RateLeg) Reset()         { *m = RateLeg{} }
+func (m *RateLeg) String() string { return proto.CompactTextString(m) }
+func (*RateLeg) ProtoMessage()    {}
+func (*RateLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{7}
 }
 
-func (x *CorrectionCorridor) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *RateLeg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateLeg.Unmarshal(m, b)
+}
+func (m *RateLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateLeg.Marshal(b, m, deterministic)
+}
+func (m *RateLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLeg.Merge(m, src)
+}
+func (m *RateLeg) XXX_Size() int {
+	return xxx_messageInfo_RateLeg.Size(m)
+}
+func (m *RateLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLeg.DiscardUnknown(m)
 }
 
-func (*CorrectionCorridor) ProtoMessage() {}
+var xxx_messageInfo_RateLeg proto.InternalMessageInfo
 
-func (x *CorrectionCorridor) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[8]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *RateLeg) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CorrectionCorridor.ProtoReflect.Descriptor instead.
-func (*CorrectionCorridor) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{8}
+func (m *RateLeg) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
 }
 
-func (x *CorrectionCorridor) GetValue() float64 {
-	if x != nil {
-		return x.Value
+func (m *RateLeg) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
 	return 0
 }
 
-type CorrectionRule struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *RateLeg) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
 
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay" json:"rate_type" bson:"rate_type"
-	RateType string `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"common_correction" bson:"common_correction"
-	CommonCorrection float64 `protobuf:"fixed64,3,opt,name=common_correction,json=commonCorrection,proto3" json:"common_correction,omitempty"`
-	// @inject_tag: validate:"omitempty,dive,keys,alpha,len=6,endkeys,gte=0,lte=100" json:"pair_correction" bson:"pair_correction"
-	PairCorrection map[string]float64 `protobuf:"bytes,4,rep,name=pair_correction,json=pairCorrection,proto3" json:"pair_correction,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	// @inject_tag: validate:"required" json:"created_at"  bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
-	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+func (m *RateLeg) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (x *CorrectionRule) Reset() {
-	*x = CorrectionRule{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[9]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *RateLeg) GetEffectiveDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.EffectiveDate
 	}
+	return nil
 }
 
-func (x *CorrectionRule) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type CardpayRate struct {
+	//@inject_tag: validate:"required" json:"created_at" bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at" validate:"required" bson:"created_at"`
+	//@inject_tag: validate:"required,alpha,len=3" json:"from" bson:"from"
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from" validate:"required,alpha,len=3" bson:"from"`
+	//@inject_tag: validate:"required,alpha,len=3" json:"to" bson:"to"
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to" validate:"required,alpha,len=3" bson:"to"`
+	//@inject_tag: validate:"required,numeric,gt=0" json:"rate" bson:"rate"
+	Rate float64 `protobuf:"fixed64,4,opt,name=rate,proto3" json:"rate" validate:"required,numeric,gt=0" bson:"rate"`
+	//@inject_tag: validate:"required,alpha" json:"source" bson:"source"
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source" validate:"required,alpha" bson:"source"`
+	//@inject_tag: validate:"numeric" json:"volume" bson:"volume"
+	Volume               float64  `protobuf:"fixed64,6,opt,name=volume,proto3" json:"volume" validate:"numeric" bson:"volume"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (*CorrectionRule) ProtoMessage() {}
+func (m *CardpayRate) Reset()         { *m = CardpayRate{} }
+func (m *CardpayRate) String() string { return proto.CompactTextString(m) }
+func (*CardpayRate) ProtoMessage()    {}
+func (*CardpayRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{8}
+}
 
-func (x *CorrectionRule) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[9]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *CardpayRate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CardpayRate.Unmarshal(m, b)
+}
+func (m *CardpayRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CardpayRate.Marshal(b, m, deterministic)
+}
+func (m *CardpayRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CardpayRate.Merge(m, src)
+}
+func (m *CardpayRate) XXX_Size() int {
+	return xxx_messageInfo_CardpayRate.Size(m)
+}
+func (m *CardpayRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CardpayRate.DiscardUnknown(m)
 }
 
-// Deprecated: Use CorrectionRule.ProtoReflect.Descriptor instead.
-func (*CorrectionRule) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{9}
+var xxx_messageInfo_CardpayRate proto.InternalMessageInfo
+
+func (m *CardpayRate) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (x *CorrectionRule) GetId() string {
-	if x != nil {
-		return x.Id
+func (m *CardpayRate) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *CorrectionRule) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *CardpayRate) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *CorrectionRule) GetCommonCorrection() float64 {
-	if x != nil {
-		return x.CommonCorrection
+func (m *CardpayRate) GetRate() float64 {
+	if m != nil {
+		return m.Rate
 	}
 	return 0
 }
 
-func (x *CorrectionRule) GetPairCorrection() map[string]float64 {
-	if x != nil {
-		return x.PairCorrection
+func (m *CardpayRate) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
-	return nil
+	return ""
 }
 
-func (x *CorrectionRule) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (m *CardpayRate) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
 	}
-	return nil
+	return 0
 }
 
-func (x *CorrectionRule) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
-	}
-	return ""
+type EmptyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CorrectionRule) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
-	}
-	return ""
+func (m *EmptyResponse) Reset()         { *m = EmptyResponse{} }
+func (m *EmptyResponse) String() string { return proto.CompactTextString(m) }
+func (*EmptyResponse) ProtoMessage()    {}
+func (*EmptyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{9}
 }
 
-type CommonCorrectionRule struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *EmptyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyResponse.Unmarshal(m, b)
+}
+func (m *EmptyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyResponse.Marshal(b, m, deterministic)
+}
+func (m *EmptyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyResponse.Merge(m, src)
+}
+func (m *EmptyResponse) XXX_Size() int {
+	return xxx_messageInfo_EmptyResponse.Size(m)
+}
+func (m *EmptyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyResponse.DiscardUnknown(m)
+}
 
-	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay" json:"rate_type" bson:"rate_type"
-	RateType string `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"common_correction" bson:"common_correction"
-	CommonCorrection float64 `protobuf:"fixed64,3,opt,name=common_correction,json=commonCorrection,proto3" json:"common_correction,omitempty"`
-	// @inject_tag: validate:"omitempty,dive,keys,alpha,len=6,endkeys,gte=0,lte=100" json:"pair_correction" bson:"pair_correction"
-	PairCorrection map[string]float64 `protobuf:"bytes,4,rep,name=pair_correction,json=pairCorrection,proto3" json:"pair_correction,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	// @inject_tag: validate:"required" json:"created_at"  bson:"created_at"
-	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+var xxx_messageInfo_EmptyResponse proto.InternalMessageInfo
+
+type EmptyRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CommonCorrectionRule) Reset() {
-	*x = CommonCorrectionRule{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *EmptyRequest) Reset()         { *m = EmptyRequest{} }
+func (m *EmptyRequest) String() string { return proto.CompactTextString(m) }
+func (*EmptyRequest) ProtoMessage()    {}
+func (*EmptyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{10}
 }
 
-func (x *CommonCorrectionRule) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *EmptyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EmptyRequest.Unmarshal(m, b)
+}
+func (m *EmptyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EmptyRequest.Marshal(b, m, deterministic)
+}
+func (m *EmptyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmptyRequest.Merge(m, src)
+}
+func (m *EmptyRequest) XXX_Size() int {
+	return xxx_messageInfo_EmptyRequest.Size(m)
+}
+func (m *EmptyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmptyRequest.DiscardUnknown(m)
 }
 
-func (*CommonCorrectionRule) ProtoMessage() {}
+var xxx_messageInfo_EmptyRequest proto.InternalMessageInfo
 
-func (x *CommonCorrectionRule) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type CorrectionCorridor struct {
+	// half-width of the band around the OXR rate as a fraction of the rate, 0.05 allows the rate to move for 5%
+	//@inject_tag: validate:"numeric,gte=0,lte=1"
+	Value float64 `protobuf:"fixed64,1,opt,name=value,proto3" json:"value,omitempty" validate:"numeric,gte=0,lte=1"`
+	//@inject_tag: validate:"required,alpha,len=6" json:"pair"
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair" validate:"required,alpha,len=6"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	// id of the user who made the change
+	//@inject_tag: validate:"required" json:"updated_by"
+	UpdatedBy            string   `protobuf:"bytes,4,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CorrectionCorridor) Reset()         { *m = CorrectionCorridor{} }
+func (m *CorrectionCorridor) String() string { return proto.CompactTextString(m) }
+func (*CorrectionCorridor) ProtoMessage()    {}
+func (*CorrectionCorridor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{11}
 }
 
-// Deprecated: Use CommonCorrectionRule.ProtoReflect.Descriptor instead.
-func (*CommonCorrectionRule) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{10}
+func (m *CorrectionCorridor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionCorridor.Unmarshal(m, b)
 }
-
-func (x *CommonCorrectionRule) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (m *CorrectionCorridor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionCorridor.Marshal(b, m, deterministic)
 }
-
-func (x *CommonCorrectionRule) GetRateType() string {
-	if x != nil {
-		return x.RateType
-	}
-	return ""
+func (m *CorrectionCorridor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionCorridor.Merge(m, src)
+}
+func (m *CorrectionCorridor) XXX_Size() int {
+	return xxx_messageInfo_CorrectionCorridor.Size(m)
+}
+func (m *CorrectionCorridor) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionCorridor.DiscardUnknown(m)
 }
 
-func (x *CommonCorrectionRule) GetCommonCorrection() float64 {
-	if x != nil {
-		return x.CommonCorrection
+var xxx_messageInfo_CorrectionCorridor proto.InternalMessageInfo
+
+func (m *CorrectionCorridor) GetValue() float64 {
+	if m != nil {
+		return m.Value
 	}
 	return 0
 }
 
-func (x *CommonCorrectionRule) GetPairCorrection() map[string]float64 {
-	if x != nil {
-		return x.PairCorrection
+func (m *CorrectionCorridor) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
-	return nil
+	return ""
 }
 
-func (x *CommonCorrectionRule) GetCreatedAt() *timestamp.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (m *CorrectionCorridor) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
 	}
 	return nil
 }
 
-func (x *CommonCorrectionRule) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *CorrectionCorridor) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
 	}
 	return ""
 }
 
-type CommonCorrectionRuleRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,2,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+type CorrectionCorridorRequest struct {
+	//@inject_tag: validate:"required,alpha,len=6" json:"pair"
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair" validate:"required,alpha,len=6"`
+	// id of the user who made the change
+	//@inject_tag: validate:"required" json:"user_id"
+	UserId               string   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CommonCorrectionRuleRequest) Reset() {
-	*x = CommonCorrectionRuleRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *CorrectionCorridorRequest) Reset()         { *m = CorrectionCorridorRequest{} }
+func (m *CorrectionCorridorRequest) String() string { return proto.CompactTextString(m) }
+func (*CorrectionCorridorRequest) ProtoMessage()    {}
+func (*CorrectionCorridorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{12}
 }
 
-func (x *CommonCorrectionRuleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *CorrectionCorridorRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionCorridorRequest.Unmarshal(m, b)
 }
-
-func (*CommonCorrectionRuleRequest) ProtoMessage() {}
-
-func (x *CommonCorrectionRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *CorrectionCorridorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionCorridorRequest.Marshal(b, m, deterministic)
 }
-
-// Deprecated: Use CommonCorrectionRuleRequest.ProtoReflect.Descriptor instead.
-func (*CommonCorrectionRuleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{11}
+func (m *CorrectionCorridorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionCorridorRequest.Merge(m, src)
+}
+func (m *CorrectionCorridorRequest) XXX_Size() int {
+	return xxx_messageInfo_CorrectionCorridorRequest.Size(m)
+}
+func (m *CorrectionCorridorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionCorridorRequest.DiscardUnknown(m)
 }
 
-func (x *CommonCorrectionRuleRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+var xxx_messageInfo_CorrectionCorridorRequest proto.InternalMessageInfo
+
+func (m *CorrectionCorridorRequest) GetPair() string {
+	if m != nil {
+		return m.Pair
 	}
 	return ""
 }
 
-func (x *CommonCorrectionRuleRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *CorrectionCorridorRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
 	}
 	return ""
 }
 
-type MerchantCorrectionRuleRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbank stock cardpay"
-	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
-	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,3,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+type CorrectionCorridorsResponse struct {
+	Items                []*CorrectionCorridor `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *MerchantCorrectionRuleRequest) Reset() {
-	*x = MerchantCorrectionRuleRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[12]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *CorrectionCorridorsResponse) Reset()         { *m = CorrectionCorridorsResponse{} }
+func (m *CorrectionCorridorsResponse) String() string { return proto.CompactTextString(m) }
+func (*CorrectionCorridorsResponse) ProtoMessage()    {}
+func (*CorrectionCorridorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{13}
 }
 
-func (x *MerchantCorrectionRuleRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *CorrectionCorridorsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionCorridorsResponse.Unmarshal(m, b)
+}
+func (m *CorrectionCorridorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionCorridorsResponse.Marshal(b, m, deterministic)
+}
+func (m *CorrectionCorridorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionCorridorsResponse.Merge(m, src)
+}
+func (m *CorrectionCorridorsResponse) XXX_Size() int {
+	return xxx_messageInfo_CorrectionCorridorsResponse.Size(m)
+}
+func (m *CorrectionCorridorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionCorridorsResponse.DiscardUnknown(m)
 }
 
-func (*MerchantCorrectionRuleRequest) ProtoMessage() {}
+var xxx_messageInfo_CorrectionCorridorsResponse proto.InternalMessageInfo
 
-func (x *MerchantCorrectionRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[12]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *CorrectionCorridorsResponse) GetItems() []*CorrectionCorridor {
+	if m != nil {
+		return m.Items
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use MerchantCorrectionRuleRequest.ProtoReflect.Descriptor instead.
-func (*MerchantCorrectionRuleRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{12}
+type CorrectionRule struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24" bson:"_id"`
+	//@inject_tag: validate:"required,rate_type" json:"rate_type" bson:"rate_type"
+	RateType string `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type" validate:"required,rate_type" bson:"rate_type"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"common_correction" bson:"common_correction"
+	CommonCorrection float64 `protobuf:"fixed64,3,opt,name=common_correction,json=commonCorrection,proto3" json:"common_correction" validate:"omitempty,numeric,gte=0,lte=100" bson:"common_correction"`
+	// correction per pair or currency pattern ("USD*", "*TRY"), the exact pair takes priority over the patterns
+	// @inject_tag: validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0,lte=100" json:"pair_correction" bson:"pair_correction"
+	PairCorrection map[string]float64 `protobuf:"bytes,4,rep,name=pair_correction,json=pairCorrection,proto3" json:"pair_correction" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3" validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0,lte=100" bson:"pair_correction"`
+	// @inject_tag: validate:"required" json:"created_at"  bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at" validate:"required" bson:"created_at"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"merchant_id" bson:"merchant_id"
+	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"omitempty,hexadecimal,len=24" bson:"merchant_id"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// the rule is applied from this time, the time of creation by default
+	//@inject_tag: json:"valid_from" bson:"valid_from"
+	ValidFrom *timestamp.Timestamp `protobuf:"bytes,8,opt,name=valid_from,json=validFrom,proto3" json:"valid_from" bson:"valid_from"`
+	// the rule is applied until this time, empty for the rule without end
+	//@inject_tag: json:"valid_to" bson:"valid_to"
+	ValidTo *timestamp.Timestamp `protobuf:"bytes,9,opt,name=valid_to,json=validTo,proto3" json:"valid_to" bson:"valid_to"`
+	// kind of the rule, one of percent, fixed_spread, tiered, percent if empty
+	//@inject_tag: validate:"omitempty,oneof=percent fixed_spread tiered" json:"kind" bson:"kind"
+	Kind string `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind" validate:"omitempty,oneof=percent fixed_spread tiered" bson:"kind"`
+	// fixed spread in basis points of the quote currency unit, for the fixed_spread rules
+	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"common_spread" bson:"common_spread"
+	CommonSpread float64 `protobuf:"fixed64,11,opt,name=common_spread,json=commonSpread,proto3" json:"common_spread" validate:"omitempty,numeric,gte=0" bson:"common_spread"`
+	// @inject_tag: validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0" json:"pair_spread" bson:"pair_spread"
+	PairSpread map[string]float64 `protobuf:"bytes,12,rep,name=pair_spread,json=pairSpread,proto3" json:"pair_spread" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3" validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0" bson:"pair_spread"`
+	// amount bands with correction in percent, for the tiered rules
+	// @inject_tag: validate:"omitempty,dive" json:"tiers" bson:"tiers"
+	Tiers                []*CorrectionTier `protobuf:"bytes,13,rep,name=tiers,proto3" json:"tiers" validate:"omitempty,dive" bson:"tiers"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CorrectionRule) Reset()         { *m = CorrectionRule{} }
+func (m *CorrectionRule) String() string { return proto.CompactTextString(m) }
+func (*CorrectionRule) ProtoMessage()    {}
+func (*CorrectionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{14}
 }
 
-func (x *MerchantCorrectionRuleRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
-	}
-	return ""
+func (m *CorrectionRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionRule.Unmarshal(m, b)
+}
+func (m *CorrectionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionRule.Marshal(b, m, deterministic)
 }
+func (m *CorrectionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionRule.Merge(m, src)
+}
+func (m *CorrectionRule) XXX_Size() int {
+	return xxx_messageInfo_CorrectionRule.Size(m)
+}
+func (m *CorrectionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionRule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrectionRule proto.InternalMessageInfo
 
-func (x *MerchantCorrectionRuleRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+func (m *CorrectionRule) GetId() string {
+	if m != nil {
+		return m.Id
 	}
 	return ""
 }
 
-func (x *MerchantCorrectionRuleRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *CorrectionRule) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-type ExchangeCurrencyCurrentCommonRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	Source   string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	// @inject_tag: validate:"numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
-}
-
-func (x *ExchangeCurrencyCurrentCommonRequest) Reset() {
-	*x = ExchangeCurrencyCurrentCommonRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *CorrectionRule) GetCommonCorrection() float64 {
+	if m != nil {
+		return m.CommonCorrection
 	}
+	return 0
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *CorrectionRule) GetPairCorrection() map[string]float64 {
+	if m != nil {
+		return m.PairCorrection
+	}
+	return nil
 }
 
-func (*ExchangeCurrencyCurrentCommonRequest) ProtoMessage() {}
-
-func (x *ExchangeCurrencyCurrentCommonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *CorrectionRule) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ExchangeCurrencyCurrentCommonRequest.ProtoReflect.Descriptor instead.
-func (*ExchangeCurrencyCurrentCommonRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{13}
+func (m *CorrectionRule) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *CorrectionRule) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *CorrectionRule) GetValidFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidFrom
 	}
-	return ""
+	return nil
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *CorrectionRule) GetValidTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidTo
 	}
-	return ""
+	return nil
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *CorrectionRule) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
+func (m *CorrectionRule) GetCommonSpread() float64 {
+	if m != nil {
+		return m.CommonSpread
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyCurrentCommonRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *CorrectionRule) GetPairSpread() map[string]float64 {
+	if m != nil {
+		return m.PairSpread
 	}
-	return ""
+	return nil
 }
 
-type ExchangeCurrencyCurrentForMerchantRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *CorrectionRule) GetTiers() []*CorrectionTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
 
-	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
-	// @inject_tag: validate:"numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+type CorrectionTier struct {
+	// pair or currency pattern ("USD*", "*TRY") of the tier, the tier is applied to all pairs without own tiers if empty
+	//@inject_tag: validate:"omitempty,min=4,max=6" json:"pair" bson:"pair"
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair" validate:"omitempty,min=4,max=6" bson:"pair"`
+	// the tier is applied to the amounts starting from this one
+	// @inject_tag: validate:"numeric,gte=0" json:"amount_from" bson:"amount_from"
+	AmountFrom float64 `protobuf:"fixed64,2,opt,name=amount_from,json=amountFrom,proto3" json:"amount_from" validate:"numeric,gte=0" bson:"amount_from"`
+	// @inject_tag: validate:"numeric,gte=0,lte=100" json:"correction" bson:"correction"
+	Correction           float64  `protobuf:"fixed64,3,opt,name=correction,proto3" json:"correction" validate:"numeric,gte=0,lte=100" bson:"correction"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CorrectionTier) Reset()         { *m = CorrectionTier{} }
+func (m *CorrectionTier) String() string { return proto.CompactTextString(m) }
+func (*CorrectionTier) ProtoMessage()    {}
+func (*CorrectionTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{15}
+}
+
+func (m *CorrectionTier) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionTier.Unmarshal(m, b)
+}
+func (m *CorrectionTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionTier.Marshal(b, m, deterministic)
+}
+func (m *CorrectionTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionTier.Merge(m, src)
+}
+func (m *CorrectionTier) XXX_Size() int {
+	return xxx_messageInfo_CorrectionTier.Size(m)
+}
+func (m *CorrectionTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrectionTier proto.InternalMessageInfo
+
+func (m *CorrectionTier) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *CorrectionTier) GetAmountFrom() float64 {
+	if m != nil {
+		return m.AmountFrom
+	}
+	return 0
+}
+
+func (m *CorrectionTier) GetCorrection() float64 {
+	if m != nil {
+		return m.Correction
+	}
+	return 0
+}
+
+type CommonCorrectionRule struct {
+	// @inject_tag: validate:"required,hexadecimal,len=24" json:"id" bson:"_id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24" bson:"_id"`
+	//@inject_tag: validate:"required,rate_type" json:"rate_type" bson:"rate_type"
+	RateType string `protobuf:"bytes,2,opt,name=rate_type,json=rateType,proto3" json:"rate_type" validate:"required,rate_type" bson:"rate_type"`
+	// @inject_tag: validate:"omitempty,numeric,gte=0,lte=100" json:"common_correction" bson:"common_correction"
+	CommonCorrection float64 `protobuf:"fixed64,3,opt,name=common_correction,json=commonCorrection,proto3" json:"common_correction" validate:"omitempty,numeric,gte=0,lte=100" bson:"common_correction"`
+	// correction per pair or currency pattern ("USD*", "*TRY"), the exact pair takes priority over the patterns
+	// @inject_tag: validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0,lte=100" json:"pair_correction" bson:"pair_correction"
+	PairCorrection map[string]float64 `protobuf:"bytes,4,rep,name=pair_correction,json=pairCorrection,proto3" json:"pair_correction" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3" validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0,lte=100" bson:"pair_correction"`
+	// @inject_tag: validate:"required" json:"created_at"  bson:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at" validate:"required" bson:"created_at"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// the rule is applied from this time, the time of creation by default
+	//@inject_tag: json:"valid_from" bson:"valid_from"
+	ValidFrom *timestamp.Timestamp `protobuf:"bytes,7,opt,name=valid_from,json=validFrom,proto3" json:"valid_from" bson:"valid_from"`
+	// the rule is applied until this time, empty for the rule without end
+	//@inject_tag: json:"valid_to" bson:"valid_to"
+	ValidTo *timestamp.Timestamp `protobuf:"bytes,8,opt,name=valid_to,json=validTo,proto3" json:"valid_to" bson:"valid_to"`
+	// kind of the rule, one of percent, fixed_spread, tiered, percent if empty
+	//@inject_tag: validate:"omitempty,oneof=percent fixed_spread tiered" json:"kind" bson:"kind"
+	Kind string `protobuf:"bytes,9,opt,name=kind,proto3" json:"kind" validate:"omitempty,oneof=percent fixed_spread tiered" bson:"kind"`
+	// fixed spread in basis points of the quote currency unit, for the fixed_spread rules
+	// @inject_tag: validate:"omitempty,numeric,gte=0" json:"common_spread" bson:"common_spread"
+	CommonSpread float64 `protobuf:"fixed64,10,opt,name=common_spread,json=commonSpread,proto3" json:"common_spread" validate:"omitempty,numeric,gte=0" bson:"common_spread"`
+	// @inject_tag: validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0" json:"pair_spread" bson:"pair_spread"
+	PairSpread map[string]float64 `protobuf:"bytes,11,rep,name=pair_spread,json=pairSpread,proto3" json:"pair_spread" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3" validate:"omitempty,dive,keys,min=4,max=6,endkeys,gte=0" bson:"pair_spread"`
+	// amount bands with correction in percent, for the tiered rules
+	// @inject_tag: validate:"omitempty,dive" json:"tiers" bson:"tiers"
+	Tiers                []*CorrectionTier `protobuf:"bytes,12,rep,name=tiers,proto3" json:"tiers" validate:"omitempty,dive" bson:"tiers"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CommonCorrectionRule) Reset()         { *m = CommonCorrectionRule{} }
+func (m *CommonCorrectionRule) String() string { return proto.CompactTextString(m) }
+func (*CommonCorrectionRule) ProtoMessage()    {}
+func (*CommonCorrectionRule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{16}
+}
+
+func (m *CommonCorrectionRule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonCorrectionRule.Unmarshal(m, b)
+}
+func (m *CommonCorrectionRule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonCorrectionRule.Marshal(b, m, deterministic)
+}
+func (m *CommonCorrectionRule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonCorrectionRule.Merge(m, src)
+}
+func (m *CommonCorrectionRule) XXX_Size() int {
+	return xxx_messageInfo_CommonCorrectionRule.Size(m)
+}
+func (m *CommonCorrectionRule) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonCorrectionRule.DiscardUnknown(m)
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) Reset() {
-	*x = ExchangeCurrencyCurrentForMerchantRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[14]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+var xxx_messageInfo_CommonCorrectionRule proto.InternalMessageInfo
+
+func (m *CommonCorrectionRule) GetId() string {
+	if m != nil {
+		return m.Id
 	}
+	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *CommonCorrectionRule) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
 }
 
-func (*ExchangeCurrencyCurrentForMerchantRequest) ProtoMessage() {}
+func (m *CommonCorrectionRule) GetCommonCorrection() float64 {
+	if m != nil {
+		return m.CommonCorrection
+	}
+	return 0
+}
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[14]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *CommonCorrectionRule) GetPairCorrection() map[string]float64 {
+	if m != nil {
+		return m.PairCorrection
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use ExchangeCurrencyCurrentForMerchantRequest.ProtoReflect.Descriptor instead.
-func (*ExchangeCurrencyCurrentForMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{14}
+func (m *CommonCorrectionRule) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *CommonCorrectionRule) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *CommonCorrectionRule) GetValidFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidFrom
+	}
+	return nil
+}
+
+func (m *CommonCorrectionRule) GetValidTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.ValidTo
+	}
+	return nil
+}
+
+func (m *CommonCorrectionRule) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *CommonCorrectionRule) GetCommonSpread() float64 {
+	if m != nil {
+		return m.CommonSpread
+	}
+	return 0
+}
+
+func (m *CommonCorrectionRule) GetPairSpread() map[string]float64 {
+	if m != nil {
+		return m.PairSpread
+	}
+	return nil
+}
+
+func (m *CommonCorrectionRule) GetTiers() []*CorrectionTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+type CommonCorrectionRuleRequest struct {
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,2,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// returns the rule effective at this time, the current rule if empty
+	//@inject_tag: json:"datetime"
+	Datetime             *timestamp.Timestamp `protobuf:"bytes,3,opt,name=datetime,proto3" json:"datetime"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CommonCorrectionRuleRequest) Reset()         { *m = CommonCorrectionRuleRequest{} }
+func (m *CommonCorrectionRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*CommonCorrectionRuleRequest) ProtoMessage()    {}
+func (*CommonCorrectionRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{17}
+}
+
+func (m *CommonCorrectionRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CommonCorrectionRuleRequest.Unmarshal(m, b)
+}
+func (m *CommonCorrectionRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CommonCorrectionRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *CommonCorrectionRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommonCorrectionRuleRequest.Merge(m, src)
+}
+func (m *CommonCorrectionRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_CommonCorrectionRuleRequest.Size(m)
+}
+func (m *CommonCorrectionRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommonCorrectionRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommonCorrectionRuleRequest proto.InternalMessageInfo
+
+func (m *CommonCorrectionRuleRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *CommonCorrectionRuleRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
+func (m *CommonCorrectionRuleRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
 	}
-	return 0
+	return nil
+}
+
+type MerchantCorrectionRuleRequest struct {
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,2,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,3,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// returns the rule effective at this time, the current rule if empty
+	//@inject_tag: json:"datetime"
+	Datetime             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=datetime,proto3" json:"datetime"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *MerchantCorrectionRuleRequest) Reset()         { *m = MerchantCorrectionRuleRequest{} }
+func (m *MerchantCorrectionRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*MerchantCorrectionRuleRequest) ProtoMessage()    {}
+func (*MerchantCorrectionRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{18}
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+func (m *MerchantCorrectionRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MerchantCorrectionRuleRequest.Unmarshal(m, b)
+}
+func (m *MerchantCorrectionRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MerchantCorrectionRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *MerchantCorrectionRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerchantCorrectionRuleRequest.Merge(m, src)
+}
+func (m *MerchantCorrectionRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_MerchantCorrectionRuleRequest.Size(m)
+}
+func (m *MerchantCorrectionRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerchantCorrectionRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerchantCorrectionRuleRequest proto.InternalMessageInfo
+
+func (m *MerchantCorrectionRuleRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyCurrentForMerchantRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *MerchantCorrectionRuleRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
 	return ""
 }
 
-type ExchangeCurrencyByDateCommonRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *MerchantCorrectionRuleRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
+
+func (m *MerchantCorrectionRuleRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
+	}
+	return nil
+}
 
+type ExchangeCurrencyCurrentCommonRequest struct {
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	// @inject_tag: validate:"numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
-	//@inject_tag: validate:"required"
-	Datetime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty" validate:"numeric,gte=0"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,8,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection string `protobuf:"bytes,6,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// rounding mode of the exchanged amount, one of half_even, half_up, ceil, floor, the service default is used if empty
+	//@inject_tag: validate:"omitempty,rounding_mode" json:"rounding_mode"
+	RoundingMode         string   `protobuf:"bytes,7,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode" validate:"omitempty,rounding_mode"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExchangeCurrencyCurrentCommonRequest) Reset()         { *m = ExchangeCurrencyCurrentCommonRequest{} }
+func (m *ExchangeCurrencyCurrentCommonRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyCurrentCommonRequest) ProtoMessage()    {}
+func (*ExchangeCurrencyCurrentCommonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{19}
+}
+
+func (m *ExchangeCurrencyCurrentCommonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyCurrentCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest.Marshal(b, m, deterministic)
+}
+func (m *ExchangeCurrencyCurrentCommonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest.Merge(m, src)
+}
+func (m *ExchangeCurrencyCurrentCommonRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest.Size(m)
+}
+func (m *ExchangeCurrencyCurrentCommonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest.DiscardUnknown(m)
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) Reset() {
-	*x = ExchangeCurrencyByDateCommonRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+var xxx_messageInfo_ExchangeCurrencyCurrentCommonRequest proto.InternalMessageInfo
+
+func (m *ExchangeCurrencyCurrentCommonRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ExchangeCurrencyCurrentCommonRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
+	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *ExchangeCurrencyCurrentCommonRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
 }
 
-func (*ExchangeCurrencyByDateCommonRequest) ProtoMessage() {}
+func (m *ExchangeCurrencyCurrentCommonRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
 
-func (x *ExchangeCurrencyByDateCommonRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *ExchangeCurrencyCurrentCommonRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use ExchangeCurrencyByDateCommonRequest.ProtoReflect.Descriptor instead.
-func (*ExchangeCurrencyByDateCommonRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{15}
+func (m *ExchangeCurrencyCurrentCommonRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
+
+func (m *ExchangeCurrencyCurrentCommonRequest) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
+}
+
+type ExchangeCurrencyCurrentForMerchantRequest struct {
+	//@inject_tag: validate:"required,alpha,len=3"
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,alpha,len=3"
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
+	// @inject_tag: validate:"numeric,gte=0"
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty" validate:"numeric,gte=0"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,7,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// rounding mode of the exchanged amount, one of half_even, half_up, ceil, floor, the service default is used if empty
+	//@inject_tag: validate:"omitempty,rounding_mode" json:"rounding_mode"
+	RoundingMode         string   `protobuf:"bytes,8,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode" validate:"omitempty,rounding_mode"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExchangeCurrencyCurrentForMerchantRequest) Reset() {
+	*m = ExchangeCurrencyCurrentForMerchantRequest{}
+}
+func (m *ExchangeCurrencyCurrentForMerchantRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*ExchangeCurrencyCurrentForMerchantRequest) ProtoMessage() {}
+func (*ExchangeCurrencyCurrentForMerchantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{20}
+}
+
+func (m *ExchangeCurrencyCurrentForMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyCurrentForMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest.Marshal(b, m, deterministic)
 }
+func (m *ExchangeCurrencyCurrentForMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest.Merge(m, src)
+}
+func (m *ExchangeCurrencyCurrentForMerchantRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest.Size(m)
+}
+func (m *ExchangeCurrencyCurrentForMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeCurrencyCurrentForMerchantRequest proto.InternalMessageInfo
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetDatetime() *timestamp.Timestamp {
-	if x != nil {
-		return x.Datetime
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
-	return nil
+	return ""
 }
 
-func (x *ExchangeCurrencyByDateCommonRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-type ExchangeCurrencyByDateForMerchantRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *ExchangeCurrencyCurrentForMerchantRequest) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
+}
 
+type ExchangeCurrencyByDateCommonRequest struct {
 	//@inject_tag: validate:"required,alpha,len=3"
-	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
 	//@inject_tag: validate:"required,alpha,len=3"
-	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
-	//@inject_tag: validate:"required,oneof=oxr paysuper centralbanks stock cardpay"
-	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty"`
-	//@inject_tag: validate:"omitempty,oneof=CBAU CBPL CBCA CBEU CBRF"
-	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
 	// @inject_tag: validate:"numeric,gte=0"
-	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
-	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
-	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty"`
+	Amount float64 `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty" validate:"numeric,gte=0"`
 	//@inject_tag: validate:"required"
-	Datetime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=datetime,proto3" json:"datetime,omitempty"`
+	Datetime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=datetime,proto3" json:"datetime,omitempty" validate:"required"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,9,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection string `protobuf:"bytes,8,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// rounding mode of the exchanged amount, one of half_even, half_up, ceil, floor, the service default is used if empty
+	//@inject_tag: validate:"omitempty,rounding_mode" json:"rounding_mode"
+	RoundingMode         string   `protobuf:"bytes,9,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode" validate:"omitempty,rounding_mode"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExchangeCurrencyByDateCommonRequest) Reset()         { *m = ExchangeCurrencyByDateCommonRequest{} }
+func (m *ExchangeCurrencyByDateCommonRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyByDateCommonRequest) ProtoMessage()    {}
+func (*ExchangeCurrencyByDateCommonRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{21}
+}
+
+func (m *ExchangeCurrencyByDateCommonRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyByDateCommonRequest.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyByDateCommonRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyByDateCommonRequest.Marshal(b, m, deterministic)
+}
+func (m *ExchangeCurrencyByDateCommonRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyByDateCommonRequest.Merge(m, src)
+}
+func (m *ExchangeCurrencyByDateCommonRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyByDateCommonRequest.Size(m)
+}
+func (m *ExchangeCurrencyByDateCommonRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyByDateCommonRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeCurrencyByDateCommonRequest proto.InternalMessageInfo
+
+func (m *ExchangeCurrencyByDateCommonRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *ExchangeCurrencyByDateCommonRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) Reset() {
-	*x = ExchangeCurrencyByDateForMerchantRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[16]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *ExchangeCurrencyByDateCommonRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
+	return ""
+}
+
+func (m *ExchangeCurrencyByDateCommonRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *ExchangeCurrencyByDateCommonRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *ExchangeCurrencyByDateCommonRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
+	}
+	return nil
 }
 
-func (*ExchangeCurrencyByDateForMerchantRequest) ProtoMessage() {}
+func (m *ExchangeCurrencyByDateCommonRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[16]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *ExchangeCurrencyByDateCommonRequest) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
 	}
-	return mi.MessageOf(x)
+	return ""
+}
+
+type ExchangeCurrencyByDateForMerchantRequest struct {
+	//@inject_tag: validate:"required,alpha,len=3"
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,alpha,len=3"
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
+	// @inject_tag: validate:"numeric,gte=0"
+	Amount float64 `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty" validate:"numeric,gte=0"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24"
+	MerchantId string `protobuf:"bytes,6,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id,omitempty" validate:"omitempty,hexadecimal,len=24"`
+	//@inject_tag: validate:"required"
+	Datetime *timestamp.Timestamp `protobuf:"bytes,7,opt,name=datetime,proto3" json:"datetime,omitempty" validate:"required"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,9,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// rounding mode of the exchanged amount, one of half_even, half_up, ceil, floor, the service default is used if empty
+	//@inject_tag: validate:"omitempty,rounding_mode" json:"rounding_mode"
+	RoundingMode         string   `protobuf:"bytes,10,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode" validate:"omitempty,rounding_mode"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-// Deprecated: Use ExchangeCurrencyByDateForMerchantRequest.ProtoReflect.Descriptor instead.
+func (m *ExchangeCurrencyByDateForMerchantRequest) Reset() {
+	*m = ExchangeCurrencyByDateForMerchantRequest{}
+}
+func (m *ExchangeCurrencyByDateForMerchantRequest) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyByDateForMerchantRequest) ProtoMessage()    {}
 func (*ExchangeCurrencyByDateForMerchantRequest) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{16}
+	return fileDescriptor_0b070f790a3987fd, []int{22}
+}
+
+func (m *ExchangeCurrencyByDateForMerchantRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyByDateForMerchantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest.Marshal(b, m, deterministic)
 }
+func (m *ExchangeCurrencyByDateForMerchantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest.Merge(m, src)
+}
+func (m *ExchangeCurrencyByDateForMerchantRequest) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest.Size(m)
+}
+func (m *ExchangeCurrencyByDateForMerchantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeCurrencyByDateForMerchantRequest proto.InternalMessageInfo
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetFrom() string {
-	if x != nil {
-		return x.From
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetTo() string {
-	if x != nil {
-		return x.To
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetTo() string {
+	if m != nil {
+		return m.To
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetRateType() string {
-	if x != nil {
-		return x.RateType
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetSource() string {
-	if x != nil {
-		return x.Source
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetSource() string {
+	if m != nil {
+		return m.Source
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetAmount() float64 {
-	if x != nil {
-		return x.Amount
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetMerchantId() string {
-	if x != nil {
-		return x.MerchantId
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
 	return ""
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetDatetime() *timestamp.Timestamp {
-	if x != nil {
-		return x.Datetime
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
 	}
 	return nil
 }
 
-func (x *ExchangeCurrencyByDateForMerchantRequest) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-type ExchangeCurrencyResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (m *ExchangeCurrencyByDateForMerchantRequest) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
+}
 
+type ExchangeCurrencyResponse struct {
 	// @inject_tag: validate:"numeric,gte=0"
-	ExchangedAmount float64 `protobuf:"fixed64,1,opt,name=exchanged_amount,json=exchangedAmount,proto3" json:"exchanged_amount,omitempty"`
+	ExchangedAmount float64 `protobuf:"fixed64,1,opt,name=exchanged_amount,json=exchangedAmount,proto3" json:"exchanged_amount,omitempty" validate:"numeric,gte=0"`
 	//@inject_tag: validate:"required,numeric,gt=0" json:"rate" bson:"exchange_rate"
-	ExchangeRate float64 `protobuf:"fixed64,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
-	// @inject_tag: validate:"omitempty,numeric,gte=-100,lte=100" json:"correction"
-	Correction float64 `protobuf:"fixed64,3,opt,name=correction,proto3" json:"correction,omitempty"`
+	ExchangeRate float64 `protobuf:"fixed64,2,opt,name=exchange_rate,json=exchangeRate,proto3" json:"rate" validate:"required,numeric,gt=0" bson:"exchange_rate"`
+	// applied correction, in percent or in basis points for the fixed_spread rules
+	// @inject_tag: validate:"omitempty,numeric" json:"correction"
+	Correction float64 `protobuf:"fixed64,3,opt,name=correction,proto3" json:"correction" validate:"omitempty,numeric"`
 	//@inject_tag: validate:"required,numeric,gt=0" json:"original_rate"
-	OriginalRate float64 `protobuf:"fixed64,4,opt,name=original_rate,json=originalRate,proto3" json:"original_rate,omitempty"`
+	OriginalRate float64 `protobuf:"fixed64,4,opt,name=original_rate,json=originalRate,proto3" json:"original_rate" validate:"required,numeric,gt=0"`
 	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction" bson:"exchange_direction"
-	ExchangeDirection string `protobuf:"bytes,5,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction,omitempty"`
+	ExchangeDirection string `protobuf:"bytes,5,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy" bson:"exchange_direction"`
+	// exchanged amount as fixed-point decimal string, rounded to the precision of the target currency
+	//@inject_tag: json:"exchanged_amount_decimal"
+	ExchangedAmountDecimal string `protobuf:"bytes,6,opt,name=exchanged_amount_decimal,json=exchangedAmountDecimal,proto3" json:"exchanged_amount_decimal"`
+	//@inject_tag: json:"rate_decimal"
+	ExchangeRateDecimal string `protobuf:"bytes,7,opt,name=exchange_rate_decimal,json=exchangeRateDecimal,proto3" json:"rate_decimal"`
+	// kind of the applied correction rule
+	//@inject_tag: json:"correction_kind"
+	CorrectionKind string `protobuf:"bytes,8,opt,name=correction_kind,json=correctionKind,proto3" json:"correction_kind"`
+	//@inject_tag: json:"provenance"
+	Provenance           *RateProvenance `protobuf:"bytes,9,opt,name=provenance,proto3" json:"provenance"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32           `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExchangeCurrencyResponse) Reset()         { *m = ExchangeCurrencyResponse{} }
+func (m *ExchangeCurrencyResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyResponse) ProtoMessage()    {}
+func (*ExchangeCurrencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{23}
 }
 
-func (x *ExchangeCurrencyResponse) Reset() {
-	*x = ExchangeCurrencyResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[17]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (m *ExchangeCurrencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Unmarshal(m, b)
 }
-
-func (x *ExchangeCurrencyResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *ExchangeCurrencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Marshal(b, m, deterministic)
 }
-
-func (*ExchangeCurrencyResponse) ProtoMessage() {}
-
-func (x *ExchangeCurrencyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[17]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (m *ExchangeCurrencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyResponse.Merge(m, src)
 }
-
-// Deprecated: Use ExchangeCurrencyResponse.ProtoReflect.Descriptor instead.
-func (*ExchangeCurrencyResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{17}
+func (m *ExchangeCurrencyResponse) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyResponse.Size(m)
+}
+func (m *ExchangeCurrencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyResponse.DiscardUnknown(m)
 }
 
-func (x *ExchangeCurrencyResponse) GetExchangedAmount() float64 {
-	if x != nil {
-		return x.ExchangedAmount
+var xxx_messageInfo_ExchangeCurrencyResponse proto.InternalMessageInfo
+
+func (m *ExchangeCurrencyResponse) GetExchangedAmount() float64 {
+	if m != nil {
+		return m.ExchangedAmount
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyResponse) GetExchangeRate() float64 {
-	if x != nil {
-		return x.ExchangeRate
+func (m *ExchangeCurrencyResponse) GetExchangeRate() float64 {
+	if m != nil {
+		return m.ExchangeRate
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyResponse) GetCorrection() float64 {
-	if x != nil {
-		return x.Correction
+func (m *ExchangeCurrencyResponse) GetCorrection() float64 {
+	if m != nil {
+		return m.Correction
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyResponse) GetOriginalRate() float64 {
-	if x != nil {
-		return x.OriginalRate
+func (m *ExchangeCurrencyResponse) GetOriginalRate() float64 {
+	if m != nil {
+		return m.OriginalRate
 	}
 	return 0
 }
 
-func (x *ExchangeCurrencyResponse) GetExchangeDirection() string {
-	if x != nil {
-		return x.ExchangeDirection
+func (m *ExchangeCurrencyResponse) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
 	}
 	return ""
 }
 
-type CurrenciesList struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Currencies []string `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+func (m *ExchangeCurrencyResponse) GetExchangedAmountDecimal() string {
+	if m != nil {
+		return m.ExchangedAmountDecimal
+	}
+	return ""
 }
 
-func (x *CurrenciesList) Reset() {
-	*x = CurrenciesList{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[18]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *ExchangeCurrencyResponse) GetExchangeRateDecimal() string {
+	if m != nil {
+		return m.ExchangeRateDecimal
 	}
+	return ""
 }
 
-func (x *CurrenciesList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *ExchangeCurrencyResponse) GetCorrectionKind() string {
+	if m != nil {
+		return m.CorrectionKind
+	}
+	return ""
 }
 
-func (*CurrenciesList) ProtoMessage() {}
-
-func (x *CurrenciesList) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[18]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *ExchangeCurrencyResponse) GetProvenance() *RateProvenance {
+	if m != nil {
+		return m.Provenance
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use CurrenciesList.ProtoReflect.Descriptor instead.
-func (*CurrenciesList) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{18}
+type BatchRequest struct {
+	//@inject_tag: validate:"required,rate_type"
+	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type,omitempty" validate:"required,rate_type"`
+	//@inject_tag: validate:"omitempty,centralbank_source"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty" validate:"omitempty,centralbank_source"`
+	// @inject_tag: validate:"required,oneof=sell buy" json:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,3,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"required,oneof=sell buy"`
+	// merchant's correction rules are applied if it's set, the common ones otherwise
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"merchant_id"
+	MerchantId string `protobuf:"bytes,4,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// rounding mode of the exchanged amounts, the service default is used if empty
+	//@inject_tag: validate:"omitempty,rounding_mode" json:"rounding_mode"
+	RoundingMode string `protobuf:"bytes,5,opt,name=rounding_mode,json=roundingMode,proto3" json:"rounding_mode" validate:"omitempty,rounding_mode"`
+	// items are validated one by one, the errors are returned per item
+	//@inject_tag: validate:"required,min=1" json:"items"
+	Items                []*BatchItem `protobuf:"bytes,6,rep,name=items,proto3" json:"items" validate:"required,min=1"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32        `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (x *CurrenciesList) GetCurrencies() []string {
-	if x != nil {
-		return x.Currencies
-	}
-	return nil
+func (m *BatchRequest) Reset()         { *m = BatchRequest{} }
+func (m *BatchRequest) String() string { return proto.CompactTextString(m) }
+func (*BatchRequest) ProtoMessage()    {}
+func (*BatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{24}
 }
 
-type CurrenciesPrecisionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Values map[string]int32 `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+func (m *BatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchRequest.Unmarshal(m, b)
+}
+func (m *BatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchRequest.Marshal(b, m, deterministic)
+}
+func (m *BatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchRequest.Merge(m, src)
+}
+func (m *BatchRequest) XXX_Size() int {
+	return xxx_messageInfo_BatchRequest.Size(m)
 }
+func (m *BatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchRequest proto.InternalMessageInfo
 
-func (x *CurrenciesPrecisionResponse) Reset() {
-	*x = CurrenciesPrecisionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (m *BatchRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
 	}
+	return ""
 }
 
-func (x *CurrenciesPrecisionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (m *BatchRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
 
-func (*CurrenciesPrecisionResponse) ProtoMessage() {}
+func (m *BatchRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
 
-func (x *CurrenciesPrecisionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_grpc_proto_currencies_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (m *BatchRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CurrenciesPrecisionResponse.ProtoReflect.Descriptor instead.
-func (*CurrenciesPrecisionResponse) Descriptor() ([]byte, []int) {
-	return file_pkg_grpc_proto_currencies_proto_rawDescGZIP(), []int{19}
+func (m *BatchRequest) GetRoundingMode() string {
+	if m != nil {
+		return m.RoundingMode
+	}
+	return ""
 }
 
-func (x *CurrenciesPrecisionResponse) GetValues() map[string]int32 {
-	if x != nil {
-		return x.Values
+func (m *BatchRequest) GetItems() []*BatchItem {
+	if m != nil {
+		return m.Items
 	}
 	return nil
 }
 
-var File_pkg_grpc_proto_currencies_proto protoreflect.FileDescriptor
-
-var file_pkg_grpc_proto_currencies_proto_rawDesc = []byte{
-	0x0a, 0x1f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x1a, 0x1f, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa5,
-	0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61,
-	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x36,
-	0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61,
-	0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xcb, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42,
-	0x79, 0x44, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
-	0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x63,
-	0x68, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
-	0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xad, 0x01, 0x0a, 0x08, 0x52, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0xb0, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x72,
-	0x64, 0x70, 0x61, 0x79, 0x52, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x22, 0x0f, 0x0a, 0x0d, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x0e, 0x0a, 0x0c,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x12,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x69, 0x64,
-	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x91, 0x03, 0x0a, 0x0e, 0x43, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72,
-	0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x10, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x0f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f,
-	0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e,
-	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
-	0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72,
-	0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x61, 0x69,
-	0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
-	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xfc, 0x02, 0x0a,
-	0x14, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x72,
-	0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x5d, 0x0a, 0x0f, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x2e, 0x50, 0x61, 0x69, 0x72, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
-	0x70, 0x61, 0x69, 0x72, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x41, 0x0a, 0x13, 0x50, 0x61, 0x69, 0x72,
-	0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x69, 0x0a, 0x1b, 0x43,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61,
-	0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72,
-	0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8c, 0x01, 0x0a, 0x1d, 0x4d, 0x65, 0x72, 0x63, 0x68,
-	0x61, 0x6e, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74,
-	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x63,
-	0x68, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc6, 0x01, 0x0a, 0x24, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63,
-	0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xec,
-	0x01, 0x0a, 0x29, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72,
-	0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d,
-	0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x01,
-	0x0a, 0x23, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x74,
-	0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x61,
-	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69,
-	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d,
-	0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa3, 0x02,
-	0x0a, 0x28, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68,
-	0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x61, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x65, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x64, 0x61, 0x74, 0x65,
-	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x18, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x29, 0x0a, 0x10, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x65, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x64, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x01, 0x52, 0x0c, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x23, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x5f, 0x72, 0x61, 0x74,
-	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
-	0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x11, 0x65, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x30, 0x0a, 0x0e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x75, 0x72, 0x72,
-	0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x1b, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x50, 0x72,
-	0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x81,
-	0x0e, 0x0a, 0x14, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x61, 0x74, 0x65, 0x73,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12,
-	0x27, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00,
-	0x12, 0x55, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x79, 0x44, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x79, 0x44, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x14, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x52, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63,
-	0x68, 0x61, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e,
-	0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x5f, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65,
-	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x74, 0x65, 0x42, 0x79, 0x44, 0x61, 0x74,
-	0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x2e, 0x52, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x22, 0x00, 0x12, 0x79, 0x0a, 0x1d, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x30, 0x2e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
-	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x83, 0x01, 0x0a, 0x22, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x12, 0x35, 0x2e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x43, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x77, 0x0a, 0x1c,
-	0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
-	0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x12, 0x2f, 0x2e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x81, 0x01, 0x0a, 0x21, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65,
-	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x12, 0x34, 0x2e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x42, 0x79, 0x44, 0x61, 0x74, 0x65, 0x46,
-	0x6f, 0x72, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x24, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45,
-	0x78, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x1b, 0x47, 0x65, 0x74,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x27, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43,
-	0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12,
-	0x68, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65,
-	0x12, 0x29, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x4d, 0x65,
-	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a, 0x1b, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x20, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x72, 0x72,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x63, 0x75, 0x72,
-	0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x1d, 0x41, 0x64, 0x64, 0x4d, 0x65,
-	0x72, 0x63, 0x68, 0x61, 0x6e, 0x74, 0x52, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x75, 0x6c, 0x65, 0x1a, 0x19, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65,
-	0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x50, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65,
-	0x64, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18,
-	0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63,
-	0x69, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69,
-	0x73, 0x74, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x56, 0x61, 0x74, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65,
-	0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e,
-	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x22, 0x00,
-	0x12, 0x51, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
-	0x67, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69,
-	0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x4c, 0x69, 0x73,
-	0x74, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x63, 0x69, 0x65, 0x73, 0x2e, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x69, 0x65, 0x73, 0x50,
-	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x42, 0x10, 0x5a, 0x0e, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
-}
-
-var (
-	file_pkg_grpc_proto_currencies_proto_rawDescOnce sync.Once
-	file_pkg_grpc_proto_currencies_proto_rawDescData = file_pkg_grpc_proto_currencies_proto_rawDesc
-)
+type BatchItem struct {
+	//@inject_tag: json:"from"
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from"`
+	//@inject_tag: json:"to"
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to"`
+	// amount to exchange, it's ignored by the rates request
+	//@inject_tag: json:"amount"
+	Amount float64 `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount"`
+	// the rate and the correction rule by this time are used, the current ones if empty
+	//@inject_tag: json:"datetime"
+	Datetime             *timestamp.Timestamp `protobuf:"bytes,4,opt,name=datetime,proto3" json:"datetime"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
 
-func file_pkg_grpc_proto_currencies_proto_rawDescGZIP() []byte {
-	file_pkg_grpc_proto_currencies_proto_rawDescOnce.Do(func() {
-		file_pkg_grpc_proto_currencies_proto_rawDescData = protoimpl.X.CompressGZIP(file_pkg_grpc_proto_currencies_proto_rawDescData)
-	})
-	return file_pkg_grpc_proto_currencies_proto_rawDescData
-}
-
-var file_pkg_grpc_proto_currencies_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
-var file_pkg_grpc_proto_currencies_proto_goTypes = []interface{}{
-	(*GetRateCurrentCommonRequest)(nil),               // 0: currencies.GetRateCurrentCommonRequest
-	(*GetRateByDateCommonRequest)(nil),                // 1: currencies.GetRateByDateCommonRequest
-	(*GetRateCurrentForMerchantRequest)(nil),          // 2: currencies.GetRateCurrentForMerchantRequest
-	(*GetRateByDateForMerchantRequest)(nil),           // 3: currencies.GetRateByDateForMerchantRequest
-	(*RateData)(nil),                                  // 4: currencies.RateData
-	(*CardpayRate)(nil),                               // 5: currencies.CardpayRate
-	(*EmptyResponse)(nil),                             // 6: currencies.EmptyResponse
-	(*EmptyRequest)(nil),                              // 7: currencies.EmptyRequest
-	(*CorrectionCorridor)(nil),                        // 8: currencies.CorrectionCorridor
-	(*CorrectionRule)(nil),                            // 9: currencies.CorrectionRule
-	(*CommonCorrectionRule)(nil),                      // 10: currencies.CommonCorrectionRule
-	(*CommonCorrectionRuleRequest)(nil),               // 11: currencies.CommonCorrectionRuleRequest
-	(*MerchantCorrectionRuleRequest)(nil),             // 12: currencies.MerchantCorrectionRuleRequest
-	(*ExchangeCurrencyCurrentCommonRequest)(nil),      // 13: currencies.ExchangeCurrencyCurrentCommonRequest
-	(*ExchangeCurrencyCurrentForMerchantRequest)(nil), // 14: currencies.ExchangeCurrencyCurrentForMerchantRequest
-	(*ExchangeCurrencyByDateCommonRequest)(nil),       // 15: currencies.ExchangeCurrencyByDateCommonRequest
-	(*ExchangeCurrencyByDateForMerchantRequest)(nil),  // 16: currencies.ExchangeCurrencyByDateForMerchantRequest
-	(*ExchangeCurrencyResponse)(nil),                  // 17: currencies.ExchangeCurrencyResponse
-	(*CurrenciesList)(nil),                            // 18: currencies.CurrenciesList
-	(*CurrenciesPrecisionResponse)(nil),               // 19: currencies.CurrenciesPrecisionResponse
-	nil,                                               // 20: currencies.CorrectionRule.PairCorrectionEntry
-	nil,                                               // 21: currencies.CommonCorrectionRule.PairCorrectionEntry
-	nil,                                               // 22: currencies.CurrenciesPrecisionResponse.ValuesEntry
-	(*timestamp.Timestamp)(nil),                       // 23: google.protobuf.Timestamp
-}
-var file_pkg_grpc_proto_currencies_proto_depIdxs = []int32{
-	23, // 0: currencies.GetRateByDateCommonRequest.datetime:type_name -> google.protobuf.Timestamp
-	23, // 1: currencies.GetRateByDateForMerchantRequest.datetime:type_name -> google.protobuf.Timestamp
-	23, // 2: currencies.RateData.created_at:type_name -> google.protobuf.Timestamp
-	23, // 3: currencies.CardpayRate.created_at:type_name -> google.protobuf.Timestamp
-	20, // 4: currencies.CorrectionRule.pair_correction:type_name -> currencies.CorrectionRule.PairCorrectionEntry
-	23, // 5: currencies.CorrectionRule.created_at:type_name -> google.protobuf.Timestamp
-	21, // 6: currencies.CommonCorrectionRule.pair_correction:type_name -> currencies.CommonCorrectionRule.PairCorrectionEntry
-	23, // 7: currencies.CommonCorrectionRule.created_at:type_name -> google.protobuf.Timestamp
-	23, // 8: currencies.ExchangeCurrencyByDateCommonRequest.datetime:type_name -> google.protobuf.Timestamp
-	23, // 9: currencies.ExchangeCurrencyByDateForMerchantRequest.datetime:type_name -> google.protobuf.Timestamp
-	22, // 10: currencies.CurrenciesPrecisionResponse.values:type_name -> currencies.CurrenciesPrecisionResponse.ValuesEntry
-	0,  // 11: currencies.CurrencyRatesService.GetRateCurrentCommon:input_type -> currencies.GetRateCurrentCommonRequest
-	1,  // 12: currencies.CurrencyRatesService.GetRateByDateCommon:input_type -> currencies.GetRateByDateCommonRequest
-	2,  // 13: currencies.CurrencyRatesService.GetRateCurrentForMerchant:input_type -> currencies.GetRateCurrentForMerchantRequest
-	3,  // 14: currencies.CurrencyRatesService.GetRateByDateForMerchant:input_type -> currencies.GetRateByDateForMerchantRequest
-	13, // 15: currencies.CurrencyRatesService.ExchangeCurrencyCurrentCommon:input_type -> currencies.ExchangeCurrencyCurrentCommonRequest
-	14, // 16: currencies.CurrencyRatesService.ExchangeCurrencyCurrentForMerchant:input_type -> currencies.ExchangeCurrencyCurrentForMerchantRequest
-	15, // 17: currencies.CurrencyRatesService.ExchangeCurrencyByDateCommon:input_type -> currencies.ExchangeCurrencyByDateCommonRequest
-	16, // 18: currencies.CurrencyRatesService.ExchangeCurrencyByDateForMerchant:input_type -> currencies.ExchangeCurrencyByDateForMerchantRequest
-	11, // 19: currencies.CurrencyRatesService.GetCommonRateCorrectionRule:input_type -> currencies.CommonCorrectionRuleRequest
-	12, // 20: currencies.CurrencyRatesService.GetMerchantRateCorrectionRule:input_type -> currencies.MerchantCorrectionRuleRequest
-	10, // 21: currencies.CurrencyRatesService.AddCommonRateCorrectionRule:input_type -> currencies.CommonCorrectionRule
-	9,  // 22: currencies.CurrencyRatesService.AddMerchantRateCorrectionRule:input_type -> currencies.CorrectionRule
-	7,  // 23: currencies.CurrencyRatesService.GetSupportedCurrencies:input_type -> currencies.EmptyRequest
-	7,  // 24: currencies.CurrencyRatesService.GetSettlementCurrencies:input_type -> currencies.EmptyRequest
-	7,  // 25: currencies.CurrencyRatesService.GetPriceCurrencies:input_type -> currencies.EmptyRequest
-	7,  // 26: currencies.CurrencyRatesService.GetVatCurrencies:input_type -> currencies.EmptyRequest
-	7,  // 27: currencies.CurrencyRatesService.GetAccountingCurrencies:input_type -> currencies.EmptyRequest
-	7,  // 28: currencies.CurrencyRatesService.GetCurrenciesPrecision:input_type -> currencies.EmptyRequest
-	4,  // 29: currencies.CurrencyRatesService.GetRateCurrentCommon:output_type -> currencies.RateData
-	4,  // 30: currencies.CurrencyRatesService.GetRateByDateCommon:output_type -> currencies.RateData
-	4,  // 31: currencies.CurrencyRatesService.GetRateCurrentForMerchant:output_type -> currencies.RateData
-	4,  // 32: currencies.CurrencyRatesService.GetRateByDateForMerchant:output_type -> currencies.RateData
-	17, // 33: currencies.CurrencyRatesService.ExchangeCurrencyCurrentCommon:output_type -> currencies.ExchangeCurrencyResponse
-	17, // 34: currencies.CurrencyRatesService.ExchangeCurrencyCurrentForMerchant:output_type -> currencies.ExchangeCurrencyResponse
-	17, // 35: currencies.CurrencyRatesService.ExchangeCurrencyByDateCommon:output_type -> currencies.ExchangeCurrencyResponse
-	17, // 36: currencies.CurrencyRatesService.ExchangeCurrencyByDateForMerchant:output_type -> currencies.ExchangeCurrencyResponse
-	9,  // 37: currencies.CurrencyRatesService.GetCommonRateCorrectionRule:output_type -> currencies.CorrectionRule
-	9,  // 38: currencies.CurrencyRatesService.GetMerchantRateCorrectionRule:output_type -> currencies.CorrectionRule
-	6,  // 39: currencies.CurrencyRatesService.AddCommonRateCorrectionRule:output_type -> currencies.EmptyResponse
-	6,  // 40: currencies.CurrencyRatesService.AddMerchantRateCorrectionRule:output_type -> currencies.EmptyResponse
-	18, // 41: currencies.CurrencyRatesService.GetSupportedCurrencies:output_type -> currencies.CurrenciesList
-	18, // 42: currencies.CurrencyRatesService.GetSettlementCurrencies:output_type -> currencies.CurrenciesList
-	18, // 43: currencies.CurrencyRatesService.GetPriceCurrencies:output_type -> currencies.CurrenciesList
-	18, // 44: currencies.CurrencyRatesService.GetVatCurrencies:output_type -> currencies.CurrenciesList
-	18, // 45: currencies.CurrencyRatesService.GetAccountingCurrencies:output_type -> currencies.CurrenciesList
-	19, // 46: currencies.CurrencyRatesService.GetCurrenciesPrecision:output_type -> currencies.CurrenciesPrecisionResponse
-	29, // [29:47] is the sub-list for method output_type
-	11, // [11:29] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
-}
-
-func init() { file_pkg_grpc_proto_currencies_proto_init() }
-func file_pkg_grpc_proto_currencies_proto_init() {
-	if File_pkg_grpc_proto_currencies_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_pkg_grpc_proto_currencies_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRateCurrentCommonRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRateByDateCommonRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRateCurrentForMerchantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRateByDateForMerchantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RateData); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CardpayRate); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmptyRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CorrectionCorridor); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CorrectionRule); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommonCorrectionRule); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CommonCorrectionRuleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MerchantCorrectionRuleRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeCurrencyCurrentCommonRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeCurrencyCurrentForMerchantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeCurrencyByDateCommonRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeCurrencyByDateForMerchantRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExchangeCurrencyResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrenciesList); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_pkg_grpc_proto_currencies_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CurrenciesPrecisionResponse); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-	}
-	type x struct{}
-	out := protoimpl.TypeBuilder{
-		File: protoimpl.DescBuilder{
-			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pkg_grpc_proto_currencies_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   23,
-			NumExtensions: 0,
-			NumServices:   1,
-		},
-		GoTypes:           file_pkg_grpc_proto_currencies_proto_goTypes,
-		DependencyIndexes: file_pkg_grpc_proto_currencies_proto_depIdxs,
-		MessageInfos:      file_pkg_grpc_proto_currencies_proto_msgTypes,
-	}.Build()
-	File_pkg_grpc_proto_currencies_proto = out.File
-	file_pkg_grpc_proto_currencies_proto_rawDesc = nil
-	file_pkg_grpc_proto_currencies_proto_goTypes = nil
-	file_pkg_grpc_proto_currencies_proto_depIdxs = nil
+func (m *BatchItem) Reset()         { *m = BatchItem{} }
+func (m *BatchItem) String() string { return proto.CompactTextString(m) }
+func (*BatchItem) ProtoMessage()    {}
+func (*BatchItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{25}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConnInterface
+func (m *BatchItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BatchItem.Unmarshal(m, b)
+}
+func (m *BatchItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BatchItem.Marshal(b, m, deterministic)
+}
+func (m *BatchItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchItem.Merge(m, src)
+}
+func (m *BatchItem) XXX_Size() int {
+	return xxx_messageInfo_BatchItem.Size(m)
+}
+func (m *BatchItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchItem.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion6
+var xxx_messageInfo_BatchItem proto.InternalMessageInfo
 
-// CurrencyRatesServiceClient is the client API for CurrencyRatesService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type CurrencyRatesServiceClient interface {
-	GetRateCurrentCommon(ctx context.Context, in *GetRateCurrentCommonRequest, opts ...grpc.CallOption) (*RateData, error)
-	GetRateByDateCommon(ctx context.Context, in *GetRateByDateCommonRequest, opts ...grpc.CallOption) (*RateData, error)
-	GetRateCurrentForMerchant(ctx context.Context, in *GetRateCurrentForMerchantRequest, opts ...grpc.CallOption) (*RateData, error)
-	GetRateByDateForMerchant(ctx context.Context, in *GetRateByDateForMerchantRequest, opts ...grpc.CallOption) (*RateData, error)
-	ExchangeCurrencyCurrentCommon(ctx context.Context, in *ExchangeCurrencyCurrentCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyCurrentForMerchant(ctx context.Context, in *ExchangeCurrencyCurrentForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyByDateCommon(ctx context.Context, in *ExchangeCurrencyByDateCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyByDateForMerchant(ctx context.Context, in *ExchangeCurrencyByDateForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
-	GetCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error)
-	GetMerchantRateCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error)
-	AddCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error)
-	AddMerchantRateCorrectionRule(ctx context.Context, in *CorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error)
-	GetSupportedCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
-	GetSettlementCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
-	GetPriceCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
-	GetVatCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
-	GetAccountingCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
-	GetCurrenciesPrecision(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error)
+func (m *BatchItem) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
 }
 
-type currencyRatesServiceClient struct {
-	cc grpc.ClientConnInterface
+func (m *BatchItem) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
 }
 
-func NewCurrencyRatesServiceClient(cc grpc.ClientConnInterface) CurrencyRatesServiceClient {
-	return &currencyRatesServiceClient{cc}
+func (m *BatchItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func (c *currencyRatesServiceClient) GetRateCurrentCommon(ctx context.Context, in *GetRateCurrentCommonRequest, opts ...grpc.CallOption) (*RateData, error) {
-	out := new(RateData)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateCurrentCommon", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BatchItem) GetDatetime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Datetime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) GetRateByDateCommon(ctx context.Context, in *GetRateByDateCommonRequest, opts ...grpc.CallOption) (*RateData, error) {
-	out := new(RateData)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateByDateCommon", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RatesBatchResponse struct {
+	// results in the order of the request items
+	//@inject_tag: json:"items"
+	Items                []*RatesBatchResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (c *currencyRatesServiceClient) GetRateCurrentForMerchant(ctx context.Context, in *GetRateCurrentForMerchantRequest, opts ...grpc.CallOption) (*RateData, error) {
-	out := new(RateData)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateCurrentForMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RatesBatchResponse) Reset()         { *m = RatesBatchResponse{} }
+func (m *RatesBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*RatesBatchResponse) ProtoMessage()    {}
+func (*RatesBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{26}
 }
 
-func (c *currencyRatesServiceClient) GetRateByDateForMerchant(ctx context.Context, in *GetRateByDateForMerchantRequest, opts ...grpc.CallOption) (*RateData, error) {
-	out := new(RateData)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateByDateForMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RatesBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatesBatchResponse.Unmarshal(m, b)
+}
+func (m *RatesBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatesBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *RatesBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatesBatchResponse.Merge(m, src)
+}
+func (m *RatesBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_RatesBatchResponse.Size(m)
+}
+func (m *RatesBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatesBatchResponse.DiscardUnknown(m)
 }
 
-func (c *currencyRatesServiceClient) ExchangeCurrencyCurrentCommon(ctx context.Context, in *ExchangeCurrencyCurrentCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
-	out := new(ExchangeCurrencyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentCommon", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RatesBatchResponse proto.InternalMessageInfo
+
+func (m *RatesBatchResponse) GetItems() []*RatesBatchResult {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) ExchangeCurrencyCurrentForMerchant(ctx context.Context, in *ExchangeCurrencyCurrentForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
-	out := new(ExchangeCurrencyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentForMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type RatesBatchResult struct {
+	//@inject_tag: json:"rate,omitempty"
+	Rate *RateData `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	//@inject_tag: json:"error,omitempty"
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (c *currencyRatesServiceClient) ExchangeCurrencyByDateCommon(ctx context.Context, in *ExchangeCurrencyByDateCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
-	out := new(ExchangeCurrencyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyByDateCommon", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RatesBatchResult) Reset()         { *m = RatesBatchResult{} }
+func (m *RatesBatchResult) String() string { return proto.CompactTextString(m) }
+func (*RatesBatchResult) ProtoMessage()    {}
+func (*RatesBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{27}
 }
 
-func (c *currencyRatesServiceClient) ExchangeCurrencyByDateForMerchant(ctx context.Context, in *ExchangeCurrencyByDateForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
-	out := new(ExchangeCurrencyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyByDateForMerchant", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *RatesBatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatesBatchResult.Unmarshal(m, b)
+}
+func (m *RatesBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatesBatchResult.Marshal(b, m, deterministic)
+}
+func (m *RatesBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatesBatchResult.Merge(m, src)
+}
+func (m *RatesBatchResult) XXX_Size() int {
+	return xxx_messageInfo_RatesBatchResult.Size(m)
+}
+func (m *RatesBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatesBatchResult.DiscardUnknown(m)
 }
 
-func (c *currencyRatesServiceClient) GetCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error) {
-	out := new(CorrectionRule)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCommonRateCorrectionRule", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RatesBatchResult proto.InternalMessageInfo
+
+func (m *RatesBatchResult) GetRate() *RateData {
+	if m != nil {
+		return m.Rate
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) GetMerchantRateCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error) {
-	out := new(CorrectionRule)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetMerchantRateCorrectionRule", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RatesBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *currencyRatesServiceClient) AddCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/AddCommonRateCorrectionRule", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ExchangeCurrencyBatchResponse struct {
+	// results in the order of the request items
+	//@inject_tag: json:"items"
+	Items                []*ExchangeCurrencyBatchResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                          `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-func (c *currencyRatesServiceClient) AddMerchantRateCorrectionRule(ctx context.Context, in *CorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error) {
-	out := new(EmptyResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/AddMerchantRateCorrectionRule", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExchangeCurrencyBatchResponse) Reset()         { *m = ExchangeCurrencyBatchResponse{} }
+func (m *ExchangeCurrencyBatchResponse) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyBatchResponse) ProtoMessage()    {}
+func (*ExchangeCurrencyBatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{28}
 }
 
-func (c *currencyRatesServiceClient) GetSupportedCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
-	out := new(CurrenciesList)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetSupportedCurrencies", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ExchangeCurrencyBatchResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyBatchResponse.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyBatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyBatchResponse.Marshal(b, m, deterministic)
+}
+func (m *ExchangeCurrencyBatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyBatchResponse.Merge(m, src)
+}
+func (m *ExchangeCurrencyBatchResponse) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyBatchResponse.Size(m)
+}
+func (m *ExchangeCurrencyBatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyBatchResponse.DiscardUnknown(m)
 }
 
-func (c *currencyRatesServiceClient) GetSettlementCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
-	out := new(CurrenciesList)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetSettlementCurrencies", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ExchangeCurrencyBatchResponse proto.InternalMessageInfo
+
+func (m *ExchangeCurrencyBatchResponse) GetItems() []*ExchangeCurrencyBatchResult {
+	if m != nil {
+		return m.Items
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) GetPriceCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
-	out := new(CurrenciesList)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetPriceCurrencies", in, out, opts...)
-	if err != nil {
-		return nil, err
+type ExchangeCurrencyBatchResult struct {
+	//@inject_tag: json:"result,omitempty"
+	Result *ExchangeCurrencyResponse `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	//@inject_tag: json:"error,omitempty"
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ExchangeCurrencyBatchResult) Reset()         { *m = ExchangeCurrencyBatchResult{} }
+func (m *ExchangeCurrencyBatchResult) String() string { return proto.CompactTextString(m) }
+func (*ExchangeCurrencyBatchResult) ProtoMessage()    {}
+func (*ExchangeCurrencyBatchResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{29}
+}
+
+func (m *ExchangeCurrencyBatchResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeCurrencyBatchResult.Unmarshal(m, b)
+}
+func (m *ExchangeCurrencyBatchResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeCurrencyBatchResult.Marshal(b, m, deterministic)
+}
+func (m *ExchangeCurrencyBatchResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeCurrencyBatchResult.Merge(m, src)
+}
+func (m *ExchangeCurrencyBatchResult) XXX_Size() int {
+	return xxx_messageInfo_ExchangeCurrencyBatchResult.Size(m)
+}
+func (m *ExchangeCurrencyBatchResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeCurrencyBatchResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExchangeCurrencyBatchResult proto.InternalMessageInfo
+
+func (m *ExchangeCurrencyBatchResult) GetResult() *ExchangeCurrencyResponse {
+	if m != nil {
+		return m.Result
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) GetVatCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
-	out := new(CurrenciesList)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetVatCurrencies", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ExchangeCurrencyBatchResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *currencyRatesServiceClient) GetAccountingCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
-	out := new(CurrenciesList)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetAccountingCurrencies", in, out, opts...)
-	if err != nil {
-		return nil, err
+type SubscribeRatesRequest struct {
+	// updates of these rate types are sent, all rate types if empty
+	//@inject_tag: validate:"omitempty,dive,rate_type" json:"rate_types"
+	RateTypes []string `protobuf:"bytes,1,rep,name=rate_types,json=rateTypes,proto3" json:"rate_types" validate:"omitempty,dive,rate_type"`
+	// updates of these sources are sent, all sources if empty
+	//@inject_tag: json:"sources"
+	Sources              []string `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *SubscribeRatesRequest) Reset()         { *m = SubscribeRatesRequest{} }
+func (m *SubscribeRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRatesRequest) ProtoMessage()    {}
+func (*SubscribeRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{30}
+}
+
+func (m *SubscribeRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRatesRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRatesRequest.Merge(m, src)
+}
+func (m *SubscribeRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRatesRequest.Size(m)
+}
+func (m *SubscribeRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRatesRequest proto.InternalMessageInfo
+
+func (m *SubscribeRatesRequest) GetRateTypes() []string {
+	if m != nil {
+		return m.RateTypes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *currencyRatesServiceClient) GetCurrenciesPrecision(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error) {
-	out := new(CurrenciesPrecisionResponse)
-	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCurrenciesPrecision", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SubscribeRatesRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
 	}
-	return out, nil
+	return nil
 }
 
-// CurrencyRatesServiceServer is the server API for CurrencyRatesService service.
-type CurrencyRatesServiceServer interface {
-	GetRateCurrentCommon(context.Context, *GetRateCurrentCommonRequest) (*RateData, error)
-	GetRateByDateCommon(context.Context, *GetRateByDateCommonRequest) (*RateData, error)
-	GetRateCurrentForMerchant(context.Context, *GetRateCurrentForMerchantRequest) (*RateData, error)
-	GetRateByDateForMerchant(context.Context, *GetRateByDateForMerchantRequest) (*RateData, error)
-	ExchangeCurrencyCurrentCommon(context.Context, *ExchangeCurrencyCurrentCommonRequest) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyCurrentForMerchant(context.Context, *ExchangeCurrencyCurrentForMerchantRequest) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyByDateCommon(context.Context, *ExchangeCurrencyByDateCommonRequest) (*ExchangeCurrencyResponse, error)
-	ExchangeCurrencyByDateForMerchant(context.Context, *ExchangeCurrencyByDateForMerchantRequest) (*ExchangeCurrencyResponse, error)
-	GetCommonRateCorrectionRule(context.Context, *CommonCorrectionRuleRequest) (*CorrectionRule, error)
-	GetMerchantRateCorrectionRule(context.Context, *MerchantCorrectionRuleRequest) (*CorrectionRule, error)
-	AddCommonRateCorrectionRule(context.Context, *CommonCorrectionRule) (*EmptyResponse, error)
-	AddMerchantRateCorrectionRule(context.Context, *CorrectionRule) (*EmptyResponse, error)
-	GetSupportedCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
-	GetSettlementCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
-	GetPriceCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
-	GetVatCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
-	GetAccountingCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
-	GetCurrenciesPrecision(context.Context, *EmptyRequest) (*CurrenciesPrecisionResponse, error)
+type RatesUpdate struct {
+	//@inject_tag: json:"rate_type"
+	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type"`
+	//@inject_tag: json:"source"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	// newly stored rates of the rate type and the source
+	//@inject_tag: json:"rates"
+	Rates []*RateData `protobuf:"bytes,3,rep,name=rates,proto3" json:"rates"`
+	//@inject_tag: json:"created_at"
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
 }
 
-// UnimplementedCurrencyRatesServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedCurrencyRatesServiceServer struct {
+func (m *RatesUpdate) Reset()         { *m = RatesUpdate{} }
+func (m *RatesUpdate) String() string { return proto.CompactTextString(m) }
+func (*RatesUpdate) ProtoMessage()    {}
+func (*RatesUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{31}
 }
 
-func (*UnimplementedCurrencyRatesServiceServer) GetRateCurrentCommon(context.Context, *GetRateCurrentCommonRequest) (*RateData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRateCurrentCommon not implemented")
+func (m *RatesUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RatesUpdate.Unmarshal(m, b)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetRateByDateCommon(context.Context, *GetRateByDateCommonRequest) (*RateData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRateByDateCommon not implemented")
+func (m *RatesUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RatesUpdate.Marshal(b, m, deterministic)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetRateCurrentForMerchant(context.Context, *GetRateCurrentForMerchantRequest) (*RateData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRateCurrentForMerchant not implemented")
+func (m *RatesUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RatesUpdate.Merge(m, src)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetRateByDateForMerchant(context.Context, *GetRateByDateForMerchantRequest) (*RateData, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRateByDateForMerchant not implemented")
+func (m *RatesUpdate) XXX_Size() int {
+	return xxx_messageInfo_RatesUpdate.Size(m)
 }
-func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyCurrentCommon(context.Context, *ExchangeCurrencyCurrentCommonRequest) (*ExchangeCurrencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyCurrentCommon not implemented")
+func (m *RatesUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RatesUpdate.DiscardUnknown(m)
 }
-func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyCurrentForMerchant(context.Context, *ExchangeCurrencyCurrentForMerchantRequest) (*ExchangeCurrencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyCurrentForMerchant not implemented")
+
+var xxx_messageInfo_RatesUpdate proto.InternalMessageInfo
+
+func (m *RatesUpdate) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
 }
-func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyByDateCommon(context.Context, *ExchangeCurrencyByDateCommonRequest) (*ExchangeCurrencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyByDateCommon not implemented")
+
+func (m *RatesUpdate) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
 }
-func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyByDateForMerchant(context.Context, *ExchangeCurrencyByDateForMerchantRequest) (*ExchangeCurrencyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyByDateForMerchant not implemented")
+
+func (m *RatesUpdate) GetRates() []*RateData {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetCommonRateCorrectionRule(context.Context, *CommonCorrectionRuleRequest) (*CorrectionRule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommonRateCorrectionRule not implemented")
+
+func (m *RatesUpdate) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetMerchantRateCorrectionRule(context.Context, *MerchantCorrectionRuleRequest) (*CorrectionRule, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantRateCorrectionRule not implemented")
+
+type RateHistoryRequest struct {
+	//@inject_tag: validate:"required,alpha,len=3" json:"from"
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,alpha,len=3" json:"to"
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to" validate:"required,alpha,len=3"`
+	//@inject_tag: validate:"required,rate_type" json:"rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type" validate:"required,rate_type"`
+	// required for the central banks rates, rates of all sources of other rate types are returned if empty
+	//@inject_tag: validate:"omitempty,rate_source" json:"source"
+	Source string `protobuf:"bytes,4,opt,name=source,proto3" json:"source" validate:"omitempty,rate_source"`
+	// side of the stock rates, required for the stock rates
+	//@inject_tag: validate:"omitempty,oneof=sell buy" json:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,5,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"omitempty,oneof=sell buy"`
+	// first day of the series, inclusive
+	//@inject_tag: validate:"required" json:"date_from"
+	DateFrom *timestamp.Timestamp `protobuf:"bytes,6,opt,name=date_from,json=dateFrom,proto3" json:"date_from" validate:"required"`
+	// last day of the series, inclusive
+	//@inject_tag: validate:"required" json:"date_to"
+	DateTo *timestamp.Timestamp `protobuf:"bytes,7,opt,name=date_to,json=dateTo,proto3" json:"date_to" validate:"required"`
+	// one of daily, weekly, monthly, the rates are returned without aggregation if empty
+	//@inject_tag: validate:"omitempty,oneof=daily weekly monthly" json:"aggregation"
+	Aggregation          string   `protobuf:"bytes,8,opt,name=aggregation,proto3" json:"aggregation" validate:"omitempty,oneof=daily weekly monthly"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
 }
-func (*UnimplementedCurrencyRatesServiceServer) AddCommonRateCorrectionRule(context.Context, *CommonCorrectionRule) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCommonRateCorrectionRule not implemented")
+
+func (m *RateHistoryRequest) Reset()         { *m = RateHistoryRequest{} }
+func (m *RateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*RateHistoryRequest) ProtoMessage()    {}
+func (*RateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{32}
 }
-func (*UnimplementedCurrencyRatesServiceServer) AddMerchantRateCorrectionRule(context.Context, *CorrectionRule) (*EmptyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddMerchantRateCorrectionRule not implemented")
+
+func (m *RateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateHistoryRequest.Unmarshal(m, b)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetSupportedCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedCurrencies not implemented")
+func (m *RateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *RateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateHistoryRequest.Merge(m, src)
+}
+func (m *RateHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_RateHistoryRequest.Size(m)
+}
+func (m *RateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateHistoryRequest proto.InternalMessageInfo
+
+func (m *RateHistoryRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *RateHistoryRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *RateHistoryRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
+}
+
+func (m *RateHistoryRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *RateHistoryRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
+
+func (m *RateHistoryRequest) GetDateFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateFrom
+	}
+	return nil
+}
+
+func (m *RateHistoryRequest) GetDateTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.DateTo
+	}
+	return nil
+}
+
+func (m *RateHistoryRequest) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+type RateHistoryResponse struct {
+	// rates by effective date ascending, filled if the aggregation is not requested
+	//@inject_tag: json:"rates,omitempty"
+	Rates []*RateData `protobuf:"bytes,1,rep,name=rates,proto3" json:"rates,omitempty"`
+	// aggregated periods by start ascending, filled if the aggregation is requested
+	//@inject_tag: json:"periods,omitempty"
+	Periods              []*RateHistoryPeriod `protobuf:"bytes,2,rep,name=periods,proto3" json:"periods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RateHistoryResponse) Reset()         { *m = RateHistoryResponse{} }
+func (m *RateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*RateHistoryResponse) ProtoMessage()    {}
+func (*RateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{33}
+}
+
+func (m *RateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateHistoryResponse.Unmarshal(m, b)
+}
+func (m *RateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *RateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateHistoryResponse.Merge(m, src)
+}
+func (m *RateHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_RateHistoryResponse.Size(m)
+}
+func (m *RateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateHistoryResponse proto.InternalMessageInfo
+
+func (m *RateHistoryResponse) GetRates() []*RateData {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *RateHistoryResponse) GetPeriods() []*RateHistoryPeriod {
+	if m != nil {
+		return m.Periods
+	}
+	return nil
+}
+
+type RateHistoryPeriod struct {
+	// start of the day, of the ISO week or of the month in UTC
+	//@inject_tag: json:"period_start"
+	PeriodStart *timestamp.Timestamp `protobuf:"bytes,1,opt,name=period_start,json=periodStart,proto3" json:"period_start"`
+	// the first rate of the period
+	//@inject_tag: json:"open"
+	Open float64 `protobuf:"fixed64,2,opt,name=open,proto3" json:"open"`
+	// the last rate of the period
+	//@inject_tag: json:"close"
+	Close float64 `protobuf:"fixed64,3,opt,name=close,proto3" json:"close"`
+	//@inject_tag: json:"min"
+	Min float64 `protobuf:"fixed64,4,opt,name=min,proto3" json:"min"`
+	//@inject_tag: json:"max"
+	Max float64 `protobuf:"fixed64,5,opt,name=max,proto3" json:"max"`
+	//@inject_tag: json:"average"
+	Average float64 `protobuf:"fixed64,6,opt,name=average,proto3" json:"average"`
+	// number of the rates in the period
+	//@inject_tag: json:"count"
+	Count                int32    `protobuf:"varint,7,opt,name=count,proto3" json:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RateHistoryPeriod) Reset()         { *m = RateHistoryPeriod{} }
+func (m *RateHistoryPeriod) String() string { return proto.CompactTextString(m) }
+func (*RateHistoryPeriod) ProtoMessage()    {}
+func (*RateHistoryPeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{34}
+}
+
+func (m *RateHistoryPeriod) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateHistoryPeriod.Unmarshal(m, b)
+}
+func (m *RateHistoryPeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateHistoryPeriod.Marshal(b, m, deterministic)
+}
+func (m *RateHistoryPeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateHistoryPeriod.Merge(m, src)
+}
+func (m *RateHistoryPeriod) XXX_Size() int {
+	return xxx_messageInfo_RateHistoryPeriod.Size(m)
+}
+func (m *RateHistoryPeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateHistoryPeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateHistoryPeriod proto.InternalMessageInfo
+
+func (m *RateHistoryPeriod) GetPeriodStart() *timestamp.Timestamp {
+	if m != nil {
+		return m.PeriodStart
+	}
+	return nil
+}
+
+func (m *RateHistoryPeriod) GetOpen() float64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *RateHistoryPeriod) GetClose() float64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *RateHistoryPeriod) GetMin() float64 {
+	if m != nil {
+		return m.Min
+	}
+	return 0
+}
+
+func (m *RateHistoryPeriod) GetMax() float64 {
+	if m != nil {
+		return m.Max
+	}
+	return 0
+}
+
+func (m *RateHistoryPeriod) GetAverage() float64 {
+	if m != nil {
+		return m.Average
+	}
+	return 0
+}
+
+func (m *RateHistoryPeriod) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type CurrenciesList struct {
+	Currencies           []string `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrenciesList) Reset()         { *m = CurrenciesList{} }
+func (m *CurrenciesList) String() string { return proto.CompactTextString(m) }
+func (*CurrenciesList) ProtoMessage()    {}
+func (*CurrenciesList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{35}
+}
+
+func (m *CurrenciesList) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrenciesList.Unmarshal(m, b)
+}
+func (m *CurrenciesList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrenciesList.Marshal(b, m, deterministic)
+}
+func (m *CurrenciesList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrenciesList.Merge(m, src)
+}
+func (m *CurrenciesList) XXX_Size() int {
+	return xxx_messageInfo_CurrenciesList.Size(m)
+}
+func (m *CurrenciesList) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrenciesList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrenciesList proto.InternalMessageInfo
+
+func (m *CurrenciesList) GetCurrencies() []string {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+type CurrenciesPrecisionResponse struct {
+	Values               map[string]int32 `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte           `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32            `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrenciesPrecisionResponse) Reset()         { *m = CurrenciesPrecisionResponse{} }
+func (m *CurrenciesPrecisionResponse) String() string { return proto.CompactTextString(m) }
+func (*CurrenciesPrecisionResponse) ProtoMessage()    {}
+func (*CurrenciesPrecisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{36}
+}
+
+func (m *CurrenciesPrecisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrenciesPrecisionResponse.Unmarshal(m, b)
+}
+func (m *CurrenciesPrecisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrenciesPrecisionResponse.Marshal(b, m, deterministic)
+}
+func (m *CurrenciesPrecisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrenciesPrecisionResponse.Merge(m, src)
+}
+func (m *CurrenciesPrecisionResponse) XXX_Size() int {
+	return xxx_messageInfo_CurrenciesPrecisionResponse.Size(m)
+}
+func (m *CurrenciesPrecisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrenciesPrecisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrenciesPrecisionResponse proto.InternalMessageInfo
+
+func (m *CurrenciesPrecisionResponse) GetValues() map[string]int32 {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type CurrencyDefinition struct {
+	//@inject_tag: validate:"required,alpha,len=3,uppercase" json:"code"
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code" validate:"required,alpha,len=3,uppercase"`
+	//@inject_tag: json:"settlement"
+	Settlement bool `protobuf:"varint,2,opt,name=settlement,proto3" json:"settlement"`
+	//@inject_tag: json:"price"
+	Price bool `protobuf:"varint,3,opt,name=price,proto3" json:"price"`
+	//@inject_tag: json:"vat"
+	Vat bool `protobuf:"varint,4,opt,name=vat,proto3" json:"vat"`
+	//@inject_tag: json:"local"
+	Local bool `protobuf:"varint,5,opt,name=local,proto3" json:"local"`
+	//@inject_tag: json:"accounting"
+	Accounting bool `protobuf:"varint,6,opt,name=accounting,proto3" json:"accounting"`
+	//@inject_tag: validate:"gte=0,lte=4" json:"precision"
+	Precision int32 `protobuf:"varint,7,opt,name=precision,proto3" json:"precision" validate:"gte=0,lte=4"`
+	//@inject_tag: validate:"gte=0,lte=4" json:"minor_units"
+	MinorUnits int32 `protobuf:"varint,8,opt,name=minor_units,json=minorUnits,proto3" json:"minor_units" validate:"gte=0,lte=4"`
+	// current version of the definition, pass it on update to prevent overwriting of concurrent changes
+	//@inject_tag: validate:"gte=0" json:"version"
+	Version int64 `protobuf:"varint,9,opt,name=version,proto3" json:"version" validate:"gte=0"`
+	//@inject_tag: json:"updated_at"
+	UpdatedAt *timestamp.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at"`
+	// id of the user who made the change
+	//@inject_tag: validate:"required" json:"updated_by"
+	UpdatedBy            string   `protobuf:"bytes,11,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyDefinition) Reset()         { *m = CurrencyDefinition{} }
+func (m *CurrencyDefinition) String() string { return proto.CompactTextString(m) }
+func (*CurrencyDefinition) ProtoMessage()    {}
+func (*CurrencyDefinition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{37}
+}
+
+func (m *CurrencyDefinition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyDefinition.Unmarshal(m, b)
+}
+func (m *CurrencyDefinition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyDefinition.Marshal(b, m, deterministic)
+}
+func (m *CurrencyDefinition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyDefinition.Merge(m, src)
+}
+func (m *CurrencyDefinition) XXX_Size() int {
+	return xxx_messageInfo_CurrencyDefinition.Size(m)
+}
+func (m *CurrencyDefinition) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyDefinition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyDefinition proto.InternalMessageInfo
+
+func (m *CurrencyDefinition) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *CurrencyDefinition) GetSettlement() bool {
+	if m != nil {
+		return m.Settlement
+	}
+	return false
+}
+
+func (m *CurrencyDefinition) GetPrice() bool {
+	if m != nil {
+		return m.Price
+	}
+	return false
+}
+
+func (m *CurrencyDefinition) GetVat() bool {
+	if m != nil {
+		return m.Vat
+	}
+	return false
+}
+
+func (m *CurrencyDefinition) GetLocal() bool {
+	if m != nil {
+		return m.Local
+	}
+	return false
+}
+
+func (m *CurrencyDefinition) GetAccounting() bool {
+	if m != nil {
+		return m.Accounting
+	}
+	return false
+}
+
+func (m *CurrencyDefinition) GetPrecision() int32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
+func (m *CurrencyDefinition) GetMinorUnits() int32 {
+	if m != nil {
+		return m.MinorUnits
+	}
+	return 0
+}
+
+func (m *CurrencyDefinition) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CurrencyDefinition) GetUpdatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return nil
+}
+
+func (m *CurrencyDefinition) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+type CurrenciesDefinitionsResponse struct {
+	Currencies           []*CurrencyDefinition `protobuf:"bytes,1,rep,name=currencies,proto3" json:"currencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrenciesDefinitionsResponse) Reset()         { *m = CurrenciesDefinitionsResponse{} }
+func (m *CurrenciesDefinitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*CurrenciesDefinitionsResponse) ProtoMessage()    {}
+func (*CurrenciesDefinitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{38}
+}
+
+func (m *CurrenciesDefinitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrenciesDefinitionsResponse.Unmarshal(m, b)
+}
+func (m *CurrenciesDefinitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrenciesDefinitionsResponse.Marshal(b, m, deterministic)
+}
+func (m *CurrenciesDefinitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrenciesDefinitionsResponse.Merge(m, src)
+}
+func (m *CurrenciesDefinitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_CurrenciesDefinitionsResponse.Size(m)
+}
+func (m *CurrenciesDefinitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrenciesDefinitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrenciesDefinitionsResponse proto.InternalMessageInfo
+
+func (m *CurrenciesDefinitionsResponse) GetCurrencies() []*CurrencyDefinition {
+	if m != nil {
+		return m.Currencies
+	}
+	return nil
+}
+
+type CurrencyRequest struct {
+	//@inject_tag: validate:"required,alpha,len=3,uppercase" json:"code"
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code" validate:"required,alpha,len=3,uppercase"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyRequest) Reset()         { *m = CurrencyRequest{} }
+func (m *CurrencyRequest) String() string { return proto.CompactTextString(m) }
+func (*CurrencyRequest) ProtoMessage()    {}
+func (*CurrencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{39}
+}
+
+func (m *CurrencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyRequest.Unmarshal(m, b)
+}
+func (m *CurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyRequest.Marshal(b, m, deterministic)
+}
+func (m *CurrencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyRequest.Merge(m, src)
+}
+func (m *CurrencyRequest) XXX_Size() int {
+	return xxx_messageInfo_CurrencyRequest.Size(m)
+}
+func (m *CurrencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyRequest proto.InternalMessageInfo
+
+func (m *CurrencyRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type DeleteCurrencyRequest struct {
+	//@inject_tag: validate:"required,alpha,len=3,uppercase" json:"code"
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code" validate:"required,alpha,len=3,uppercase"`
+	// id of the user who made the change
+	//@inject_tag: validate:"required" json:"updated_by"
+	UpdatedBy            string   `protobuf:"bytes,2,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *DeleteCurrencyRequest) Reset()         { *m = DeleteCurrencyRequest{} }
+func (m *DeleteCurrencyRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCurrencyRequest) ProtoMessage()    {}
+func (*DeleteCurrencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{40}
+}
+
+func (m *DeleteCurrencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCurrencyRequest.Unmarshal(m, b)
+}
+func (m *DeleteCurrencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCurrencyRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteCurrencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCurrencyRequest.Merge(m, src)
+}
+func (m *DeleteCurrencyRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCurrencyRequest.Size(m)
+}
+func (m *DeleteCurrencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCurrencyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCurrencyRequest proto.InternalMessageInfo
+
+func (m *DeleteCurrencyRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *DeleteCurrencyRequest) GetUpdatedBy() string {
+	if m != nil {
+		return m.UpdatedBy
+	}
+	return ""
+}
+
+type CurrencyHistoryItem struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"code"
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code"`
+	// one of create, update, delete
+	//@inject_tag: json:"action"
+	Action string `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+	//@inject_tag: json:"version"
+	Version int64 `protobuf:"varint,4,opt,name=version,proto3" json:"version"`
+	//@inject_tag: json:"before"
+	Before *CurrencyDefinition `protobuf:"bytes,5,opt,name=before,proto3" json:"before"`
+	//@inject_tag: json:"after"
+	After *CurrencyDefinition `protobuf:"bytes,6,opt,name=after,proto3" json:"after"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	//@inject_tag: json:"created_by"
+	CreatedBy            string   `protobuf:"bytes,8,opt,name=created_by,json=createdBy,proto3" json:"created_by"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyHistoryItem) Reset()         { *m = CurrencyHistoryItem{} }
+func (m *CurrencyHistoryItem) String() string { return proto.CompactTextString(m) }
+func (*CurrencyHistoryItem) ProtoMessage()    {}
+func (*CurrencyHistoryItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{41}
+}
+
+func (m *CurrencyHistoryItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyHistoryItem.Unmarshal(m, b)
+}
+func (m *CurrencyHistoryItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyHistoryItem.Marshal(b, m, deterministic)
+}
+func (m *CurrencyHistoryItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyHistoryItem.Merge(m, src)
+}
+func (m *CurrencyHistoryItem) XXX_Size() int {
+	return xxx_messageInfo_CurrencyHistoryItem.Size(m)
+}
+func (m *CurrencyHistoryItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyHistoryItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyHistoryItem proto.InternalMessageInfo
+
+func (m *CurrencyHistoryItem) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *CurrencyHistoryItem) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *CurrencyHistoryItem) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *CurrencyHistoryItem) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CurrencyHistoryItem) GetBefore() *CurrencyDefinition {
+	if m != nil {
+		return m.Before
+	}
+	return nil
+}
+
+func (m *CurrencyHistoryItem) GetAfter() *CurrencyDefinition {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
+func (m *CurrencyHistoryItem) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *CurrencyHistoryItem) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+type CurrencyHistoryResponse struct {
+	Items                []*CurrencyHistoryItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                 `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                  `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CurrencyHistoryResponse) Reset()         { *m = CurrencyHistoryResponse{} }
+func (m *CurrencyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*CurrencyHistoryResponse) ProtoMessage()    {}
+func (*CurrencyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{42}
+}
+
+func (m *CurrencyHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CurrencyHistoryResponse.Unmarshal(m, b)
+}
+func (m *CurrencyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CurrencyHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *CurrencyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CurrencyHistoryResponse.Merge(m, src)
+}
+func (m *CurrencyHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_CurrencyHistoryResponse.Size(m)
+}
+func (m *CurrencyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CurrencyHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CurrencyHistoryResponse proto.InternalMessageInfo
+
+func (m *CurrencyHistoryResponse) GetItems() []*CurrencyHistoryItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RateAnomaly struct {
+	//@inject_tag: json:"pair"
+	Pair string `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair"`
+	//@inject_tag: json:"rate"
+	Rate float64 `protobuf:"fixed64,2,opt,name=rate,proto3" json:"rate"`
+	// previous - previous rate of the same source, oxr - market rate of the same pair
+	//@inject_tag: json:"reference"
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference"`
+	//@inject_tag: json:"reference_rate"
+	ReferenceRate float64 `protobuf:"fixed64,4,opt,name=reference_rate,json=referenceRate,proto3" json:"reference_rate"`
+	// deviation from the reference rate in percent
+	//@inject_tag: json:"deviation"
+	Deviation float64 `protobuf:"fixed64,5,opt,name=deviation,proto3" json:"deviation"`
+	//@inject_tag: json:"threshold"
+	Threshold            float64  `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RateAnomaly) Reset()         { *m = RateAnomaly{} }
+func (m *RateAnomaly) String() string { return proto.CompactTextString(m) }
+func (*RateAnomaly) ProtoMessage()    {}
+func (*RateAnomaly) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{43}
+}
+
+func (m *RateAnomaly) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RateAnomaly.Unmarshal(m, b)
+}
+func (m *RateAnomaly) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RateAnomaly.Marshal(b, m, deterministic)
+}
+func (m *RateAnomaly) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateAnomaly.Merge(m, src)
+}
+func (m *RateAnomaly) XXX_Size() int {
+	return xxx_messageInfo_RateAnomaly.Size(m)
+}
+func (m *RateAnomaly) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateAnomaly.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateAnomaly proto.InternalMessageInfo
+
+func (m *RateAnomaly) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *RateAnomaly) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *RateAnomaly) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *RateAnomaly) GetReferenceRate() float64 {
+	if m != nil {
+		return m.ReferenceRate
+	}
+	return 0
+}
+
+func (m *RateAnomaly) GetDeviation() float64 {
+	if m != nil {
+		return m.Deviation
+	}
+	return 0
+}
+
+func (m *RateAnomaly) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type QuarantinedRates struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: json:"source"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source"`
+	//@inject_tag: json:"rate_type"
+	RateType string `protobuf:"bytes,3,opt,name=rate_type,json=rateType,proto3" json:"rate_type"`
+	//@inject_tag: json:"rates"
+	Rates []*RateData `protobuf:"bytes,4,rep,name=rates,proto3" json:"rates"`
+	//@inject_tag: json:"anomalies"
+	Anomalies []*RateAnomaly `protobuf:"bytes,5,rep,name=anomalies,proto3" json:"anomalies"`
+	// one of pending, approved, rejected
+	//@inject_tag: json:"status"
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// id of the operator who approved or rejected the batch
+	//@inject_tag: json:"reviewed_by"
+	ReviewedBy string `protobuf:"bytes,8,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by"`
+	//@inject_tag: json:"reviewed_at"
+	ReviewedAt *timestamp.Timestamp `protobuf:"bytes,9,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at"`
+	//@inject_tag: json:"comment"
+	Comment              string   `protobuf:"bytes,10,opt,name=comment,proto3" json:"comment"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *QuarantinedRates) Reset()         { *m = QuarantinedRates{} }
+func (m *QuarantinedRates) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedRates) ProtoMessage()    {}
+func (*QuarantinedRates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{44}
+}
+
+func (m *QuarantinedRates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedRates.Unmarshal(m, b)
+}
+func (m *QuarantinedRates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedRates.Marshal(b, m, deterministic)
+}
+func (m *QuarantinedRates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedRates.Merge(m, src)
+}
+func (m *QuarantinedRates) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedRates.Size(m)
+}
+func (m *QuarantinedRates) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedRates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedRates proto.InternalMessageInfo
+
+func (m *QuarantinedRates) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QuarantinedRates) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *QuarantinedRates) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
+}
+
+func (m *QuarantinedRates) GetRates() []*RateData {
+	if m != nil {
+		return m.Rates
+	}
+	return nil
+}
+
+func (m *QuarantinedRates) GetAnomalies() []*RateAnomaly {
+	if m != nil {
+		return m.Anomalies
+	}
+	return nil
+}
+
+func (m *QuarantinedRates) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QuarantinedRates) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *QuarantinedRates) GetReviewedBy() string {
+	if m != nil {
+		return m.ReviewedBy
+	}
+	return ""
+}
+
+func (m *QuarantinedRates) GetReviewedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ReviewedAt
+	}
+	return nil
+}
+
+func (m *QuarantinedRates) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type QuarantinedRatesRequest struct {
+	// pending by default
+	//@inject_tag: validate:"omitempty,oneof=pending approved rejected" json:"status"
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status" validate:"omitempty,oneof=pending approved rejected"`
+	//@inject_tag: validate:"omitempty,rate_source" json:"source"
+	Source string `protobuf:"bytes,2,opt,name=source,proto3" json:"source" validate:"omitempty,rate_source"`
+	//@inject_tag: validate:"gte=0" json:"limit"
+	Limit int32 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit" validate:"gte=0"`
+	//@inject_tag: validate:"gte=0" json:"offset"
+	Offset               int32    `protobuf:"varint,4,opt,name=offset,proto3" json:"offset" validate:"gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *QuarantinedRatesRequest) Reset()         { *m = QuarantinedRatesRequest{} }
+func (m *QuarantinedRatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedRatesRequest) ProtoMessage()    {}
+func (*QuarantinedRatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{45}
+}
+
+func (m *QuarantinedRatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedRatesRequest.Unmarshal(m, b)
+}
+func (m *QuarantinedRatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedRatesRequest.Marshal(b, m, deterministic)
+}
+func (m *QuarantinedRatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedRatesRequest.Merge(m, src)
+}
+func (m *QuarantinedRatesRequest) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedRatesRequest.Size(m)
+}
+func (m *QuarantinedRatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedRatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedRatesRequest proto.InternalMessageInfo
+
+func (m *QuarantinedRatesRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QuarantinedRatesRequest) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *QuarantinedRatesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *QuarantinedRatesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type QuarantinedRatesResponse struct {
+	Items                []*QuarantinedRates `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32               `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *QuarantinedRatesResponse) Reset()         { *m = QuarantinedRatesResponse{} }
+func (m *QuarantinedRatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedRatesResponse) ProtoMessage()    {}
+func (*QuarantinedRatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{46}
+}
+
+func (m *QuarantinedRatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedRatesResponse.Unmarshal(m, b)
+}
+func (m *QuarantinedRatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedRatesResponse.Marshal(b, m, deterministic)
+}
+func (m *QuarantinedRatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedRatesResponse.Merge(m, src)
+}
+func (m *QuarantinedRatesResponse) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedRatesResponse.Size(m)
+}
+func (m *QuarantinedRatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedRatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedRatesResponse proto.InternalMessageInfo
+
+func (m *QuarantinedRatesResponse) GetItems() []*QuarantinedRates {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type QuarantinedRatesReviewRequest struct {
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
+	// id of the operator who made the decision
+	//@inject_tag: validate:"required" json:"user_id"
+	UserId string `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id" validate:"required"`
+	//@inject_tag: json:"comment"
+	Comment              string   `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *QuarantinedRatesReviewRequest) Reset()         { *m = QuarantinedRatesReviewRequest{} }
+func (m *QuarantinedRatesReviewRequest) String() string { return proto.CompactTextString(m) }
+func (*QuarantinedRatesReviewRequest) ProtoMessage()    {}
+func (*QuarantinedRatesReviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{47}
+}
+
+func (m *QuarantinedRatesReviewRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuarantinedRatesReviewRequest.Unmarshal(m, b)
+}
+func (m *QuarantinedRatesReviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuarantinedRatesReviewRequest.Marshal(b, m, deterministic)
+}
+func (m *QuarantinedRatesReviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuarantinedRatesReviewRequest.Merge(m, src)
+}
+func (m *QuarantinedRatesReviewRequest) XXX_Size() int {
+	return xxx_messageInfo_QuarantinedRatesReviewRequest.Size(m)
+}
+func (m *QuarantinedRatesReviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuarantinedRatesReviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuarantinedRatesReviewRequest proto.InternalMessageInfo
+
+func (m *QuarantinedRatesReviewRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *QuarantinedRatesReviewRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *QuarantinedRatesReviewRequest) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+type PaysuperCorrection struct {
+	//@inject_tag: json:"id"
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id"`
+	//@inject_tag: validate:"required,alpha,len=6" json:"pair"
+	Pair string `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair" validate:"required,alpha,len=6"`
+	// correction of paysuper prediction rate in percent, negative value decreases the rate
+	//@inject_tag: validate:"gt=-100,lte=100" json:"value"
+	Value float64 `protobuf:"fixed64,3,opt,name=value,proto3" json:"value" validate:"gt=-100,lte=100"`
+	//@inject_tag: json:"created_at"
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at"`
+	// id of the user who made the change
+	//@inject_tag: validate:"required" json:"created_by"
+	CreatedBy            string   `protobuf:"bytes,5,opt,name=created_by,json=createdBy,proto3" json:"created_by" validate:"required"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaysuperCorrection) Reset()         { *m = PaysuperCorrection{} }
+func (m *PaysuperCorrection) String() string { return proto.CompactTextString(m) }
+func (*PaysuperCorrection) ProtoMessage()    {}
+func (*PaysuperCorrection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{48}
+}
+
+func (m *PaysuperCorrection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaysuperCorrection.Unmarshal(m, b)
+}
+func (m *PaysuperCorrection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaysuperCorrection.Marshal(b, m, deterministic)
+}
+func (m *PaysuperCorrection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaysuperCorrection.Merge(m, src)
+}
+func (m *PaysuperCorrection) XXX_Size() int {
+	return xxx_messageInfo_PaysuperCorrection.Size(m)
+}
+func (m *PaysuperCorrection) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaysuperCorrection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaysuperCorrection proto.InternalMessageInfo
+
+func (m *PaysuperCorrection) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PaysuperCorrection) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *PaysuperCorrection) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *PaysuperCorrection) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PaysuperCorrection) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+type PaysuperCorrectionRequest struct {
+	//@inject_tag: validate:"required,alpha,len=6" json:"pair"
+	Pair                 string   `protobuf:"bytes,1,opt,name=pair,proto3" json:"pair" validate:"required,alpha,len=6"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaysuperCorrectionRequest) Reset()         { *m = PaysuperCorrectionRequest{} }
+func (m *PaysuperCorrectionRequest) String() string { return proto.CompactTextString(m) }
+func (*PaysuperCorrectionRequest) ProtoMessage()    {}
+func (*PaysuperCorrectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{49}
+}
+
+func (m *PaysuperCorrectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaysuperCorrectionRequest.Unmarshal(m, b)
+}
+func (m *PaysuperCorrectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaysuperCorrectionRequest.Marshal(b, m, deterministic)
+}
+func (m *PaysuperCorrectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaysuperCorrectionRequest.Merge(m, src)
+}
+func (m *PaysuperCorrectionRequest) XXX_Size() int {
+	return xxx_messageInfo_PaysuperCorrectionRequest.Size(m)
+}
+func (m *PaysuperCorrectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaysuperCorrectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaysuperCorrectionRequest proto.InternalMessageInfo
+
+func (m *PaysuperCorrectionRequest) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+type PaysuperCorrectionsResponse struct {
+	Items                []*PaysuperCorrection `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte                `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32                 `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *PaysuperCorrectionsResponse) Reset()         { *m = PaysuperCorrectionsResponse{} }
+func (m *PaysuperCorrectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*PaysuperCorrectionsResponse) ProtoMessage()    {}
+func (*PaysuperCorrectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{50}
+}
+
+func (m *PaysuperCorrectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PaysuperCorrectionsResponse.Unmarshal(m, b)
+}
+func (m *PaysuperCorrectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PaysuperCorrectionsResponse.Marshal(b, m, deterministic)
+}
+func (m *PaysuperCorrectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaysuperCorrectionsResponse.Merge(m, src)
+}
+func (m *PaysuperCorrectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_PaysuperCorrectionsResponse.Size(m)
+}
+func (m *PaysuperCorrectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaysuperCorrectionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PaysuperCorrectionsResponse proto.InternalMessageInfo
+
+func (m *PaysuperCorrectionsResponse) GetItems() []*PaysuperCorrection {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ListCorrectionRulesRequest struct {
+	//@inject_tag: validate:"omitempty,rate_type" json:"rate_type"
+	RateType string `protobuf:"bytes,1,opt,name=rate_type,json=rateType,proto3" json:"rate_type" validate:"omitempty,rate_type"`
+	//@inject_tag: validate:"omitempty,oneof=sell buy" json:"exchange_direction"
+	ExchangeDirection string `protobuf:"bytes,2,opt,name=exchange_direction,json=exchangeDirection,proto3" json:"exchange_direction" validate:"omitempty,oneof=sell buy"`
+	//@inject_tag: validate:"omitempty,hexadecimal,len=24" json:"merchant_id"
+	MerchantId string `protobuf:"bytes,3,opt,name=merchant_id,json=merchantId,proto3" json:"merchant_id" validate:"omitempty,hexadecimal,len=24"`
+	// returns common rules only, merchant_id is ignored
+	//@inject_tag: json:"common"
+	Common bool `protobuf:"varint,4,opt,name=common,proto3" json:"common"`
+	//@inject_tag: validate:"gte=0" json:"limit"
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit" validate:"gte=0"`
+	//@inject_tag: validate:"gte=0" json:"offset"
+	Offset               int32    `protobuf:"varint,6,opt,name=offset,proto3" json:"offset" validate:"gte=0"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *ListCorrectionRulesRequest) Reset()         { *m = ListCorrectionRulesRequest{} }
+func (m *ListCorrectionRulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCorrectionRulesRequest) ProtoMessage()    {}
+func (*ListCorrectionRulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{51}
+}
+
+func (m *ListCorrectionRulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCorrectionRulesRequest.Unmarshal(m, b)
+}
+func (m *ListCorrectionRulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCorrectionRulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCorrectionRulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCorrectionRulesRequest.Merge(m, src)
+}
+func (m *ListCorrectionRulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCorrectionRulesRequest.Size(m)
+}
+func (m *ListCorrectionRulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCorrectionRulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCorrectionRulesRequest proto.InternalMessageInfo
+
+func (m *ListCorrectionRulesRequest) GetRateType() string {
+	if m != nil {
+		return m.RateType
+	}
+	return ""
+}
+
+func (m *ListCorrectionRulesRequest) GetExchangeDirection() string {
+	if m != nil {
+		return m.ExchangeDirection
+	}
+	return ""
+}
+
+func (m *ListCorrectionRulesRequest) GetMerchantId() string {
+	if m != nil {
+		return m.MerchantId
+	}
+	return ""
+}
+
+func (m *ListCorrectionRulesRequest) GetCommon() bool {
+	if m != nil {
+		return m.Common
+	}
+	return false
+}
+
+func (m *ListCorrectionRulesRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *ListCorrectionRulesRequest) GetOffset() int32 {
+	if m != nil {
+		return m.Offset
+	}
+	return 0
+}
+
+type CorrectionRulesResponse struct {
+	// total number of rules matched the filter
+	Count                int32             `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	Items                []*CorrectionRule `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte            `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32             `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *CorrectionRulesResponse) Reset()         { *m = CorrectionRulesResponse{} }
+func (m *CorrectionRulesResponse) String() string { return proto.CompactTextString(m) }
+func (*CorrectionRulesResponse) ProtoMessage()    {}
+func (*CorrectionRulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{52}
+}
+
+func (m *CorrectionRulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CorrectionRulesResponse.Unmarshal(m, b)
+}
+func (m *CorrectionRulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CorrectionRulesResponse.Marshal(b, m, deterministic)
+}
+func (m *CorrectionRulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CorrectionRulesResponse.Merge(m, src)
+}
+func (m *CorrectionRulesResponse) XXX_Size() int {
+	return xxx_messageInfo_CorrectionRulesResponse.Size(m)
+}
+func (m *CorrectionRulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CorrectionRulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CorrectionRulesResponse proto.InternalMessageInfo
+
+func (m *CorrectionRulesResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CorrectionRulesResponse) GetItems() []*CorrectionRule {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RevertCorrectionRuleRequest struct {
+	// id of the previous rule to make effective again
+	//@inject_tag: validate:"required,hexadecimal,len=24" json:"id"
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id" validate:"required,hexadecimal,len=24"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_unrecognized     []byte   `json:"-" bson:"-" structure:"-" validate:"-"`
+	XXX_sizecache        int32    `json:"-" bson:"-" structure:"-" validate:"-"`
+}
+
+func (m *RevertCorrectionRuleRequest) Reset()         { *m = RevertCorrectionRuleRequest{} }
+func (m *RevertCorrectionRuleRequest) String() string { return proto.CompactTextString(m) }
+func (*RevertCorrectionRuleRequest) ProtoMessage()    {}
+func (*RevertCorrectionRuleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b070f790a3987fd, []int{53}
+}
+
+func (m *RevertCorrectionRuleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevertCorrectionRuleRequest.Unmarshal(m, b)
+}
+func (m *RevertCorrectionRuleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevertCorrectionRuleRequest.Marshal(b, m, deterministic)
+}
+func (m *RevertCorrectionRuleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevertCorrectionRuleRequest.Merge(m, src)
+}
+func (m *RevertCorrectionRuleRequest) XXX_Size() int {
+	return xxx_messageInfo_RevertCorrectionRuleRequest.Size(m)
+}
+func (m *RevertCorrectionRuleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevertCorrectionRuleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevertCorrectionRuleRequest proto.InternalMessageInfo
+
+func (m *RevertCorrectionRuleRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetRateCurrentCommonRequest)(nil), "currencies.GetRateCurrentCommonRequest")
+	proto.RegisterType((*GetRateByDateCommonRequest)(nil), "currencies.GetRateByDateCommonRequest")
+	proto.RegisterType((*GetRateCurrentForMerchantRequest)(nil), "currencies.GetRateCurrentForMerchantRequest")
+	proto.RegisterType((*GetRateByDateForMerchantRequest)(nil), "currencies.GetRateByDateForMerchantRequest")
+	proto.RegisterType((*RateData)(nil), "currencies.RateData")
+	proto.RegisterType((*RateProvenance)(nil), "currencies.RateProvenance")
+	proto.RegisterType((*CrossRate)(nil), "currencies.CrossRate")
+	proto.RegisterType((*RateLeg)(nil), "currencies.RateLeg")
+	proto.RegisterType((*CardpayRate)(nil), "currencies.CardpayRate")
+	proto.RegisterType((*EmptyResponse)(nil), "currencies.EmptyResponse")
+	proto.RegisterType((*EmptyRequest)(nil), "currencies.EmptyRequest")
+	proto.RegisterType((*CorrectionCorridor)(nil), "currencies.CorrectionCorridor")
+	proto.RegisterType((*CorrectionCorridorRequest)(nil), "currencies.CorrectionCorridorRequest")
+	proto.RegisterType((*CorrectionCorridorsResponse)(nil), "currencies.CorrectionCorridorsResponse")
+	proto.RegisterType((*CorrectionRule)(nil), "currencies.CorrectionRule")
+	proto.RegisterMapType((map[string]float64)(nil), "currencies.CorrectionRule.PairCorrectionEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "currencies.CorrectionRule.PairSpreadEntry")
+	proto.RegisterType((*CorrectionTier)(nil), "currencies.CorrectionTier")
+	proto.RegisterType((*CommonCorrectionRule)(nil), "currencies.CommonCorrectionRule")
+	proto.RegisterMapType((map[string]float64)(nil), "currencies.CommonCorrectionRule.PairCorrectionEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "currencies.CommonCorrectionRule.PairSpreadEntry")
+	proto.RegisterType((*CommonCorrectionRuleRequest)(nil), "currencies.CommonCorrectionRuleRequest")
+	proto.RegisterType((*MerchantCorrectionRuleRequest)(nil), "currencies.MerchantCorrectionRuleRequest")
+	proto.RegisterType((*ExchangeCurrencyCurrentCommonRequest)(nil), "currencies.ExchangeCurrencyCurrentCommonRequest")
+	proto.RegisterType((*ExchangeCurrencyCurrentForMerchantRequest)(nil), "currencies.ExchangeCurrencyCurrentForMerchantRequest")
+	proto.RegisterType((*ExchangeCurrencyByDateCommonRequest)(nil), "currencies.ExchangeCurrencyByDateCommonRequest")
+	proto.RegisterType((*ExchangeCurrencyByDateForMerchantRequest)(nil), "currencies.ExchangeCurrencyByDateForMerchantRequest")
+	proto.RegisterType((*ExchangeCurrencyResponse)(nil), "currencies.ExchangeCurrencyResponse")
+	proto.RegisterType((*BatchRequest)(nil), "currencies.BatchRequest")
+	proto.RegisterType((*BatchItem)(nil), "currencies.BatchItem")
+	proto.RegisterType((*RatesBatchResponse)(nil), "currencies.RatesBatchResponse")
+	proto.RegisterType((*RatesBatchResult)(nil), "currencies.RatesBatchResult")
+	proto.RegisterType((*ExchangeCurrencyBatchResponse)(nil), "currencies.ExchangeCurrencyBatchResponse")
+	proto.RegisterType((*ExchangeCurrencyBatchResult)(nil), "currencies.ExchangeCurrencyBatchResult")
+	proto.RegisterType((*SubscribeRatesRequest)(nil), "currencies.SubscribeRatesRequest")
+	proto.RegisterType((*RatesUpdate)(nil), "currencies.RatesUpdate")
+	proto.RegisterType((*RateHistoryRequest)(nil), "currencies.RateHistoryRequest")
+	proto.RegisterType((*RateHistoryResponse)(nil), "currencies.RateHistoryResponse")
+	proto.RegisterType((*RateHistoryPeriod)(nil), "currencies.RateHistoryPeriod")
+	proto.RegisterType((*CurrenciesList)(nil), "currencies.CurrenciesList")
+	proto.RegisterType((*CurrenciesPrecisionResponse)(nil), "currencies.CurrenciesPrecisionResponse")
+	proto.RegisterMapType((map[string]int32)(nil), "currencies.CurrenciesPrecisionResponse.ValuesEntry")
+	proto.RegisterType((*CurrencyDefinition)(nil), "currencies.CurrencyDefinition")
+	proto.RegisterType((*CurrenciesDefinitionsResponse)(nil), "currencies.CurrenciesDefinitionsResponse")
+	proto.RegisterType((*CurrencyRequest)(nil), "currencies.CurrencyRequest")
+	proto.RegisterType((*DeleteCurrencyRequest)(nil), "currencies.DeleteCurrencyRequest")
+	proto.RegisterType((*CurrencyHistoryItem)(nil), "currencies.CurrencyHistoryItem")
+	proto.RegisterType((*CurrencyHistoryResponse)(nil), "currencies.CurrencyHistoryResponse")
+	proto.RegisterType((*RateAnomaly)(nil), "currencies.RateAnomaly")
+	proto.RegisterType((*QuarantinedRates)(nil), "currencies.QuarantinedRates")
+	proto.RegisterType((*QuarantinedRatesRequest)(nil), "currencies.QuarantinedRatesRequest")
+	proto.RegisterType((*QuarantinedRatesResponse)(nil), "currencies.QuarantinedRatesResponse")
+	proto.RegisterType((*QuarantinedRatesReviewRequest)(nil), "currencies.QuarantinedRatesReviewRequest")
+	proto.RegisterType((*PaysuperCorrection)(nil), "currencies.PaysuperCorrection")
+	proto.RegisterType((*PaysuperCorrectionRequest)(nil), "currencies.PaysuperCorrectionRequest")
+	proto.RegisterType((*PaysuperCorrectionsResponse)(nil), "currencies.PaysuperCorrectionsResponse")
+	proto.RegisterType((*ListCorrectionRulesRequest)(nil), "currencies.ListCorrectionRulesRequest")
+	proto.RegisterType((*CorrectionRulesResponse)(nil), "currencies.CorrectionRulesResponse")
+	proto.RegisterType((*RevertCorrectionRuleRequest)(nil), "currencies.RevertCorrectionRuleRequest")
+}
+
+func init() { proto.RegisterFile("pkg/grpc/proto/currencies.proto", fileDescriptor_0b070f790a3987fd) }
+
+var fileDescriptor_0b070f790a3987fd = []byte{
+	// 3078 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x6f, 0x1b, 0xd7,
+	0xd5, 0x9a, 0xa1, 0x48, 0x91, 0x87, 0x12, 0xad, 0x5c, 0xbd, 0x68, 0xc9, 0xb2, 0x94, 0x71, 0xfc,
+	0xd9, 0xce, 0x43, 0x36, 0x1c, 0x3b, 0xaf, 0xaf, 0x29, 0x20, 0xcb, 0x89, 0xe3, 0x38, 0x29, 0x94,
+	0x91, 0x93, 0x14, 0x6d, 0x12, 0x76, 0x34, 0x73, 0x49, 0x4f, 0x4d, 0x72, 0x26, 0x33, 0x97, 0x74,
+	0xd8, 0x4d, 0xd1, 0x16, 0xfd, 0x01, 0xd9, 0xb5, 0xab, 0xa2, 0x05, 0x0a, 0xb4, 0x40, 0x17, 0x2d,
+	0x0a, 0xf4, 0x17, 0x74, 0x51, 0xa0, 0x8b, 0x76, 0x57, 0x14, 0xe8, 0xb6, 0xab, 0xfc, 0x83, 0xae,
+	0x8a, 0xfb, 0x9a, 0xf7, 0x0c, 0x87, 0xb2, 0x6c, 0x34, 0x2b, 0xcd, 0x3d, 0x73, 0xee, 0x99, 0xf3,
+	0x3e, 0xf7, 0x9c, 0x4b, 0xc1, 0x8e, 0xfb, 0xb0, 0x77, 0xb5, 0xe7, 0xb9, 0xe6, 0x55, 0xd7, 0x73,
+	0x88, 0x73, 0xd5, 0x1c, 0x79, 0x1e, 0x1e, 0x9a, 0x36, 0xf6, 0xf7, 0x18, 0x00, 0x41, 0x08, 0xd9,
+	0xdc, 0xe9, 0x39, 0x4e, 0xaf, 0x8f, 0x39, 0xea, 0xf1, 0xa8, 0x7b, 0x95, 0xd8, 0x03, 0xec, 0x13,
+	0x63, 0xe0, 0x72, 0x64, 0xed, 0xd7, 0x0a, 0x6c, 0xdd, 0xc1, 0x44, 0x37, 0x08, 0x3e, 0x60, 0xdb,
+	0xc8, 0x81, 0x33, 0x18, 0x38, 0x43, 0x1d, 0x7f, 0x3e, 0xc2, 0x3e, 0x41, 0x08, 0xe6, 0xbb, 0x9e,
+	0x33, 0x68, 0x2b, 0xbb, 0xca, 0xe5, 0x86, 0xce, 0x9e, 0x51, 0x0b, 0x54, 0xe2, 0xb4, 0x55, 0x06,
+	0x51, 0x89, 0x83, 0xb6, 0xa0, 0xe1, 0x19, 0x04, 0x77, 0xc8, 0xc4, 0xc5, 0xed, 0x0a, 0x03, 0xd7,
+	0x29, 0xe0, 0xfe, 0xc4, 0xc5, 0x68, 0x1d, 0x6a, 0xbe, 0x33, 0xf2, 0x4c, 0xdc, 0x9e, 0x67, 0x6f,
+	0xc4, 0x0a, 0xbd, 0x04, 0x08, 0x7f, 0x61, 0x3e, 0x30, 0x86, 0x3d, 0xdc, 0xb1, 0x6c, 0x0f, 0x9b,
+	0xc4, 0x76, 0x86, 0xed, 0x2a, 0xc3, 0x79, 0x46, 0xbe, 0xb9, 0x2d, 0x5f, 0x68, 0xff, 0x52, 0x60,
+	0x53, 0xf0, 0x79, 0x6b, 0x72, 0x9b, 0x72, 0xfb, 0x74, 0xd8, 0x7c, 0x05, 0xea, 0x96, 0x41, 0x30,
+	0x55, 0x1b, 0x63, 0xae, 0x79, 0x7d, 0x73, 0x8f, 0xeb, 0x74, 0x4f, 0xea, 0x74, 0xef, 0xbe, 0xd4,
+	0xa9, 0x1e, 0xe0, 0xe6, 0x88, 0x57, 0xcb, 0x13, 0xef, 0xaf, 0x0a, 0xec, 0xc6, 0xcd, 0xf0, 0xb6,
+	0xe3, 0xbd, 0x8f, 0x3d, 0x8a, 0x47, 0x9e, 0xb8, 0x90, 0x3b, 0xd0, 0x1c, 0x88, 0x6f, 0x75, 0x6c,
+	0x4b, 0x18, 0x01, 0x24, 0xe8, 0xae, 0x35, 0xab, 0x34, 0x3f, 0x56, 0x61, 0x27, 0x66, 0xac, 0xa7,
+	0x29, 0xcc, 0x49, 0x2d, 0x96, 0x50, 0x42, 0xad, 0xa4, 0x12, 0x16, 0xf2, 0x94, 0xf0, 0xf3, 0x0a,
+	0xd4, 0xa9, 0x06, 0x6e, 0x1b, 0xc4, 0xa0, 0x92, 0xd9, 0x96, 0x90, 0x55, 0xb5, 0x2d, 0xf4, 0x3a,
+	0x80, 0xe9, 0x61, 0x83, 0x60, 0xab, 0x63, 0x90, 0xb6, 0x3a, 0x95, 0xcd, 0x86, 0xc0, 0xde, 0x67,
+	0x8a, 0x73, 0x0d, 0xdb, 0x13, 0xfa, 0x60, 0xcf, 0x14, 0x46, 0xf5, 0xc2, 0x34, 0xa1, 0xe8, 0xec,
+	0x39, 0xa2, 0x9f, 0x6a, 0x4c, 0x3f, 0xeb, 0x50, 0x1b, 0x3b, 0xfd, 0xd1, 0x00, 0x33, 0x11, 0x15,
+	0x5d, 0xac, 0xd0, 0x0d, 0xca, 0x92, 0xe3, 0xfb, 0x1d, 0x46, 0x69, 0x81, 0xb1, 0xb4, 0xb6, 0x17,
+	0xc9, 0x2e, 0x07, 0xf4, 0x2d, 0x95, 0x88, 0x72, 0x23, 0x1e, 0xd1, 0x3e, 0xb4, 0x70, 0xb7, 0x4b,
+	0x45, 0x1e, 0xe3, 0x0e, 0xd5, 0x65, 0xbb, 0x3e, 0x55, 0x98, 0xa5, 0x60, 0x07, 0xf5, 0x0d, 0xf4,
+	0x2c, 0x2c, 0x32, 0x2b, 0x5b, 0xd8, 0xb4, 0x07, 0x46, 0xbf, 0xdd, 0x60, 0xec, 0x36, 0x29, 0xec,
+	0x36, 0x07, 0x51, 0xf9, 0x7c, 0xdb, 0xc2, 0x6d, 0xe0, 0x32, 0xd3, 0x67, 0xf4, 0x06, 0x80, 0xeb,
+	0x39, 0x63, 0x3c, 0x34, 0x86, 0x26, 0x6e, 0x37, 0xc5, 0x57, 0x23, 0xfc, 0x52, 0xfe, 0x0e, 0x03,
+	0x0c, 0x3d, 0x82, 0xad, 0xfd, 0x42, 0x85, 0x56, 0xfc, 0x35, 0xda, 0x80, 0x05, 0xc6, 0x45, 0x60,
+	0xa6, 0x1a, 0x5d, 0xde, 0xb5, 0xe2, 0x4e, 0xa8, 0xe6, 0x3a, 0x61, 0x25, 0xa6, 0xe4, 0xb4, 0x5a,
+	0xe6, 0x67, 0x55, 0xcb, 0x26, 0xd4, 0xbb, 0x46, 0xbf, 0x7f, 0x6c, 0x98, 0x0f, 0x99, 0x05, 0xeb,
+	0x7a, 0xb0, 0x46, 0x2f, 0x02, 0x32, 0x1d, 0x4f, 0x78, 0x5a, 0xc7, 0x1b, 0xf5, 0x71, 0xe8, 0xb2,
+	0xcb, 0xe1, 0x1b, 0x7d, 0xd4, 0xa7, 0x12, 0x5c, 0x87, 0xb5, 0x24, 0xb6, 0x6f, 0x3a, 0x2e, 0x16,
+	0xbe, 0xbb, 0x12, 0xdf, 0x70, 0x44, 0x5f, 0x69, 0xef, 0x42, 0x23, 0xb0, 0x37, 0x5a, 0x85, 0xaa,
+	0x6b, 0x8f, 0x1d, 0x22, 0x34, 0xc3, 0x17, 0xe8, 0x12, 0xcc, 0xf7, 0x71, 0xcf, 0x6f, 0xab, 0xbb,
+	0x95, 0xcb, 0xcd, 0xeb, 0x2b, 0x49, 0xd5, 0xbf, 0x87, 0x7b, 0x3a, 0x43, 0xd0, 0xfe, 0xa1, 0xc0,
+	0x82, 0x80, 0xa4, 0x02, 0x41, 0x7a, 0xb3, 0x9a, 0xe1, 0xcd, 0x95, 0x4c, 0x6f, 0x8e, 0x47, 0x7b,
+	0x3c, 0x90, 0xaa, 0xb3, 0x04, 0x52, 0xda, 0x46, 0xb5, 0x19, 0x6d, 0xa4, 0xfd, 0x5e, 0x81, 0xe6,
+	0x81, 0xe1, 0x59, 0xae, 0x31, 0x61, 0x8a, 0x8a, 0x73, 0xa3, 0xcc, 0x18, 0xd6, 0x2c, 0x1f, 0xaa,
+	0xa9, 0x7c, 0x58, 0x09, 0xf2, 0xe1, 0x29, 0x84, 0xb9, 0x76, 0x06, 0x96, 0xde, 0x1a, 0xb8, 0x64,
+	0xa2, 0x63, 0xdf, 0x75, 0x86, 0x3e, 0xd6, 0x5a, 0xb0, 0x28, 0x00, 0x2c, 0x31, 0x6b, 0x3f, 0x53,
+	0x00, 0x1d, 0x04, 0x1e, 0x41, 0x9f, 0x6c, 0xcb, 0xf1, 0xa8, 0x0f, 0x8c, 0x8d, 0xfe, 0x08, 0x33,
+	0xa9, 0x14, 0x9d, 0x2f, 0x32, 0xcd, 0xf7, 0x3a, 0xc0, 0xc8, 0xb5, 0xa4, 0x12, 0x2a, 0xd3, 0x95,
+	0x20, 0xb0, 0xf7, 0x09, 0xda, 0x0e, 0xb7, 0x1e, 0x4f, 0x84, 0xa5, 0xe5, 0xeb, 0x5b, 0x13, 0xed,
+	0x1d, 0x38, 0x9b, 0xe6, 0x2c, 0x52, 0x50, 0x18, 0x2b, 0x4a, 0x84, 0x95, 0x0d, 0x58, 0x18, 0xf9,
+	0xd8, 0xa3, 0xc1, 0xc1, 0x39, 0xac, 0xd1, 0xe5, 0x5d, 0x4b, 0x3b, 0x82, 0xad, 0x34, 0x25, 0x5f,
+	0xea, 0x04, 0xdd, 0x80, 0xaa, 0x4d, 0xf0, 0xc0, 0x6f, 0x2b, 0xcc, 0xb7, 0xcf, 0xc7, 0xd2, 0x60,
+	0x9a, 0x03, 0x8e, 0xac, 0xfd, 0xa7, 0x0a, 0xad, 0x83, 0x58, 0x2c, 0xa5, 0xdc, 0xbd, 0x30, 0x99,
+	0xbc, 0x00, 0xcf, 0x98, 0xec, 0x54, 0xd3, 0x09, 0x23, 0x52, 0x04, 0xc1, 0x32, 0x7f, 0x11, 0x52,
+	0x47, 0x1f, 0xc3, 0x19, 0x2a, 0x62, 0x14, 0x75, 0x9e, 0x31, 0xbb, 0x97, 0xcd, 0x2c, 0x65, 0x67,
+	0xef, 0xd0, 0xb0, 0xbd, 0x10, 0xf4, 0xd6, 0x90, 0x78, 0x13, 0xbd, 0xe5, 0xc6, 0x80, 0x8f, 0x13,
+	0x51, 0xa7, 0x5c, 0x42, 0x29, 0x2b, 0x63, 0xa3, 0x6f, 0x5b, 0x1d, 0x16, 0x19, 0xd3, 0x0b, 0x4b,
+	0x83, 0x61, 0xbf, 0x4d, 0x43, 0xe7, 0x26, 0xd4, 0xf9, 0x56, 0xe2, 0xb4, 0x1b, 0x53, 0x37, 0x2e,
+	0x30, 0xdc, 0xfb, 0x2c, 0xc2, 0x1e, 0xda, 0x43, 0x4b, 0x16, 0x1a, 0xfa, 0x8c, 0x2e, 0xc0, 0x92,
+	0x30, 0x8b, 0xef, 0x7a, 0xd8, 0xb0, 0x58, 0xad, 0x51, 0xf4, 0x45, 0x0e, 0x3c, 0x62, 0x30, 0x74,
+	0x0f, 0x9a, 0xcc, 0x1c, 0x02, 0x65, 0x91, 0x99, 0xe2, 0xf9, 0x29, 0xa6, 0xe0, 0x7b, 0xb9, 0x19,
+	0xc0, 0x0d, 0x00, 0xe8, 0x1a, 0x54, 0x89, 0x8d, 0x3d, 0xbf, 0xbd, 0xb4, 0x5b, 0x49, 0x56, 0xb5,
+	0x90, 0xcc, 0x7d, 0x1b, 0x7b, 0x3a, 0x47, 0xdc, 0xdc, 0x87, 0x95, 0x0c, 0xdb, 0xa2, 0x65, 0xa8,
+	0x3c, 0xc4, 0x13, 0xe1, 0x7f, 0xf4, 0x31, 0x0c, 0x63, 0x35, 0x12, 0xc6, 0x6f, 0xa8, 0xaf, 0x29,
+	0x9b, 0x6f, 0xc2, 0x99, 0x04, 0x4f, 0xb3, 0x6c, 0xd7, 0x30, 0xb4, 0xe2, 0xac, 0x65, 0x06, 0xe4,
+	0x0e, 0x34, 0x8d, 0x81, 0x33, 0x1a, 0x92, 0x4e, 0x90, 0xec, 0x14, 0x1d, 0x38, 0x88, 0xd9, 0xed,
+	0x3c, 0x40, 0xca, 0xf9, 0x23, 0x10, 0xed, 0xab, 0x2a, 0xac, 0x1e, 0x24, 0x62, 0xe1, 0x09, 0x47,
+	0xda, 0xa7, 0x79, 0x91, 0x76, 0x23, 0x6e, 0x97, 0x34, 0x53, 0x4f, 0x3a, 0xde, 0x66, 0x3b, 0x96,
+	0x27, 0xc2, 0x69, 0xe1, 0xa4, 0xe1, 0x54, 0x9f, 0x3d, 0x9c, 0x1a, 0x45, 0xe1, 0x04, 0x19, 0xe1,
+	0xf4, 0x41, 0x3c, 0x9c, 0x9a, 0x4c, 0xdf, 0xd7, 0x4a, 0xe9, 0x7b, 0x6a, 0x50, 0x2d, 0x7e, 0x7d,
+	0x82, 0xea, 0x97, 0x0a, 0x6c, 0x65, 0x09, 0x2a, 0x6b, 0x5e, 0xcc, 0xc9, 0x95, 0x84, 0x93, 0x67,
+	0x7b, 0x87, 0x9a, 0xe7, 0x1d, 0xd1, 0xbe, 0xa9, 0x52, 0xbe, 0x6f, 0xd2, 0xfe, 0xac, 0xc0, 0xb6,
+	0x6c, 0xee, 0x4e, 0xc0, 0x65, 0xa2, 0x66, 0xa8, 0x25, 0x6b, 0x46, 0xa5, 0x8c, 0x18, 0xf3, 0x33,
+	0x88, 0xf1, 0x95, 0x02, 0xcf, 0xbd, 0x25, 0xa8, 0xf1, 0x16, 0xdc, 0x9c, 0x3c, 0xdd, 0x89, 0xc8,
+	0x3a, 0xd4, 0x78, 0x22, 0x64, 0x49, 0x40, 0xd1, 0xc5, 0x6a, 0xd6, 0x28, 0xbf, 0x00, 0x4b, 0x9e,
+	0x33, 0x1a, 0x5a, 0xf6, 0xb0, 0xd7, 0x19, 0x38, 0x96, 0x3c, 0xe5, 0x2f, 0x4a, 0xe0, 0xfb, 0x8e,
+	0x85, 0xb5, 0x2f, 0x55, 0xb8, 0x92, 0x23, 0xed, 0xd3, 0xec, 0xd5, 0xf3, 0x44, 0x3e, 0xed, 0x83,
+	0x44, 0x4a, 0x27, 0xf5, 0x0c, 0x9d, 0xfc, 0x4a, 0x85, 0x0b, 0x49, 0x9d, 0x3c, 0xe1, 0x59, 0x53,
+	0x35, 0x47, 0x1b, 0xb5, 0x98, 0x36, 0xa2, 0x2e, 0xbd, 0xf0, 0xd8, 0x33, 0xa8, 0x7a, 0x69, 0x25,
+	0x35, 0x32, 0x94, 0xf4, 0x17, 0x15, 0x2e, 0x67, 0x2b, 0xe9, 0x6b, 0xe1, 0x37, 0xa7, 0xab, 0xca,
+	0x46, 0x69, 0x55, 0x42, 0x86, 0x2a, 0xff, 0x50, 0x81, 0x76, 0x52, 0x95, 0x41, 0x07, 0x72, 0x05,
+	0x96, 0x25, 0x59, 0xab, 0x23, 0x64, 0xe5, 0x9d, 0xd7, 0x99, 0x00, 0xbe, 0xcf, 0x85, 0xbe, 0x00,
+	0x4b, 0x01, 0x6f, 0xac, 0x3d, 0xe4, 0x65, 0x64, 0x51, 0x02, 0x59, 0x67, 0x3a, 0xe5, 0x5c, 0x45,
+	0x89, 0x38, 0x9e, 0xdd, 0xb3, 0x87, 0x46, 0xbf, 0x13, 0xe9, 0x31, 0x17, 0x25, 0x90, 0x11, 0x99,
+	0x6d, 0x66, 0x8b, 0x5e, 0x83, 0x76, 0x52, 0x86, 0x60, 0xc8, 0xc3, 0x4d, 0xb3, 0x9e, 0x90, 0x45,
+	0xce, 0x7b, 0xae, 0xc3, 0x5a, 0x4c, 0xa4, 0x60, 0x9b, 0x98, 0x58, 0x44, 0x45, 0x93, 0x7b, 0x2e,
+	0xc1, 0x99, 0xc8, 0x94, 0x83, 0x1d, 0x3b, 0xb8, 0xab, 0xb7, 0x42, 0xf0, 0x3d, 0x7a, 0x00, 0x89,
+	0x0f, 0x8e, 0x1a, 0x33, 0x0d, 0x8e, 0xfe, 0xad, 0xc0, 0xe2, 0x2d, 0x83, 0x98, 0x0f, 0x4a, 0xd5,
+	0xb6, 0xd0, 0x7d, 0xd5, 0x12, 0xb3, 0xef, 0xdc, 0x92, 0x96, 0xf0, 0xea, 0xf9, 0x94, 0x57, 0xa7,
+	0xdc, 0xad, 0x9a, 0x76, 0x37, 0xf4, 0x82, 0xec, 0x69, 0x6b, 0xbb, 0x95, 0xe4, 0x68, 0x8f, 0x89,
+	0x74, 0x97, 0xe0, 0x81, 0x6c, 0x65, 0x7f, 0x08, 0x8d, 0x00, 0x56, 0x2a, 0x8c, 0xc3, 0x88, 0xac,
+	0xe4, 0xe6, 0xae, 0x59, 0xca, 0xf1, 0x3b, 0x80, 0xa8, 0x19, 0x7c, 0xa1, 0x6c, 0x11, 0x15, 0xd7,
+	0xe3, 0x7d, 0xf9, 0xb9, 0xa4, 0xd5, 0x02, 0xf4, 0x51, 0x9f, 0x48, 0x51, 0x74, 0x58, 0x4e, 0xbe,
+	0x42, 0x97, 0xc5, 0x20, 0x85, 0x4f, 0x68, 0x56, 0x93, 0x64, 0xe8, 0xc8, 0x56, 0x8c, 0x57, 0x56,
+	0xa1, 0x8a, 0x3d, 0xcf, 0x91, 0x13, 0x0e, 0xbe, 0xd0, 0x3e, 0x83, 0xed, 0x54, 0x12, 0x8c, 0x31,
+	0xfa, 0x66, 0x9c, 0xd1, 0x4b, 0xd1, 0x2f, 0xe4, 0xed, 0x8c, 0xf0, 0xfc, 0x39, 0x6c, 0x15, 0x60,
+	0xa1, 0x6f, 0x40, 0xcd, 0x63, 0x4f, 0x42, 0x80, 0xe7, 0x8a, 0xc8, 0x4b, 0x9e, 0x74, 0xb1, 0x27,
+	0x47, 0xa4, 0x43, 0x58, 0x3b, 0x1a, 0x1d, 0xfb, 0xa6, 0x67, 0x1f, 0xb3, 0xb0, 0xf2, 0xa5, 0x87,
+	0x6f, 0x03, 0x04, 0x1e, 0xce, 0xe5, 0x69, 0xe8, 0x0d, 0xe9, 0xe2, 0x3e, 0x6a, 0xc3, 0x02, 0xf7,
+	0x6a, 0x3e, 0x08, 0x6c, 0xe8, 0x72, 0xa9, 0xfd, 0x56, 0x81, 0x26, 0xa3, 0xf4, 0x21, 0x1b, 0xe0,
+	0x9c, 0x2c, 0x54, 0x9e, 0x87, 0x2a, 0xc5, 0xf1, 0xdb, 0x95, 0xdd, 0x4a, 0xae, 0xa9, 0x38, 0x4a,
+	0xa2, 0x93, 0x9a, 0x9f, 0xa1, 0x93, 0xd2, 0x7e, 0xa7, 0x72, 0x7f, 0x7b, 0xc7, 0xf6, 0x89, 0xe3,
+	0x4d, 0xfe, 0xc7, 0x6e, 0xbf, 0xd0, 0xab, 0xd0, 0xa0, 0x2a, 0xe4, 0x8d, 0x5b, 0xad, 0x5c, 0x18,
+	0xb1, 0xbe, 0xed, 0x65, 0x58, 0x60, 0x1b, 0x89, 0x53, 0xa2, 0xdc, 0xd5, 0x28, 0xea, 0x7d, 0x07,
+	0xed, 0x42, 0xd3, 0xe8, 0xf5, 0x3c, 0xdc, 0x33, 0x22, 0x07, 0x86, 0x28, 0x48, 0xfb, 0x01, 0xac,
+	0xc4, 0xb4, 0x25, 0xbc, 0x3e, 0x30, 0x96, 0x32, 0xdd, 0x58, 0xaf, 0xc2, 0x82, 0x8b, 0x3d, 0xdb,
+	0xb1, 0xe4, 0x00, 0x79, 0x3b, 0x89, 0x2d, 0xa8, 0x1f, 0x32, 0x2c, 0x5d, 0x62, 0x6b, 0x7f, 0x57,
+	0xe0, 0x99, 0xd4, 0x6b, 0xf4, 0x26, 0x2c, 0x72, 0x84, 0x8e, 0x4f, 0x0c, 0xaf, 0xcc, 0xec, 0xb5,
+	0xc9, 0xf1, 0x8f, 0x28, 0x3a, 0x35, 0xb4, 0xe3, 0xe2, 0xa1, 0x28, 0x9d, 0xec, 0x99, 0xc6, 0x89,
+	0xd9, 0x77, 0x7c, 0x39, 0x87, 0xe6, 0x0b, 0xda, 0xbe, 0x0d, 0xec, 0xa1, 0x28, 0x8f, 0xf4, 0x91,
+	0x41, 0x8c, 0x2f, 0xc4, 0x49, 0x84, 0x3e, 0xd2, 0x98, 0x30, 0xc6, 0xd8, 0x33, 0x7a, 0x72, 0xf8,
+	0x2a, 0x97, 0x8c, 0x26, 0xcb, 0x92, 0xd4, 0x1a, 0x55, 0x9d, 0x2f, 0xb4, 0x6b, 0xd0, 0x3a, 0x08,
+	0x64, 0x7f, 0xcf, 0xf6, 0x09, 0x2b, 0xd7, 0x01, 0x44, 0x04, 0x5d, 0x04, 0xc2, 0xae, 0x6d, 0xc3,
+	0x2d, 0x87, 0x1e, 0x36, 0x6d, 0x9f, 0x76, 0x5d, 0xd2, 0x12, 0xf7, 0xa0, 0xc6, 0xba, 0x48, 0x69,
+	0x8a, 0x97, 0x63, 0xdd, 0x6e, 0xfe, 0xc6, 0xbd, 0x8f, 0xd8, 0x2e, 0xde, 0x3d, 0x0b, 0x12, 0x9b,
+	0xaf, 0x43, 0x33, 0x02, 0x9e, 0xd6, 0xc0, 0x56, 0xa3, 0x0d, 0xec, 0x3f, 0x55, 0x40, 0x32, 0x11,
+	0xdd, 0xc6, 0x5d, 0x7b, 0x68, 0x33, 0x7f, 0x46, 0x30, 0x6f, 0xd2, 0x3a, 0x25, 0xe2, 0x8a, 0x3e,
+	0x53, 0x91, 0x7d, 0x4c, 0x48, 0x1f, 0x0f, 0xf0, 0x90, 0x5f, 0x89, 0xd5, 0xf5, 0x08, 0x84, 0x7e,
+	0xc4, 0xf5, 0x6c, 0x71, 0xd3, 0x52, 0xd7, 0xf9, 0x82, 0x32, 0x33, 0x16, 0xc1, 0x5e, 0xd7, 0xe9,
+	0x23, 0xc5, 0xeb, 0x3b, 0xa6, 0xd1, 0x17, 0x97, 0x26, 0x7c, 0x41, 0xa9, 0x1b, 0x26, 0xd3, 0xb6,
+	0x3d, 0xec, 0x31, 0xab, 0xd4, 0xf5, 0x08, 0x04, 0x9d, 0x83, 0x86, 0x2b, 0x95, 0x21, 0x8c, 0x13,
+	0x02, 0x58, 0x05, 0xb6, 0x87, 0x8e, 0xd7, 0x19, 0x0d, 0x6d, 0xe2, 0xb3, 0x88, 0xa8, 0xea, 0xc0,
+	0x40, 0x1f, 0x52, 0x08, 0xb5, 0xf8, 0x18, 0x7b, 0xbe, 0x3c, 0x14, 0x56, 0x74, 0xb9, 0x4c, 0x4c,
+	0xc3, 0xe1, 0xe4, 0xd3, 0xf0, 0x66, 0x72, 0x1a, 0xde, 0x81, 0xed, 0xd0, 0x92, 0xa1, 0x72, 0xc3,
+	0x29, 0xf6, 0x37, 0x53, 0x4e, 0x94, 0x1c, 0x65, 0xa7, 0x2c, 0x13, 0x73, 0xb2, 0x8b, 0x70, 0x26,
+	0x2c, 0x22, 0x41, 0x42, 0x4c, 0x1a, 0x4e, 0x7b, 0x17, 0xd6, 0x6e, 0xe3, 0x3e, 0x26, 0xb8, 0x04,
+	0x72, 0x42, 0x26, 0x35, 0x29, 0xd3, 0x1f, 0x55, 0x58, 0x91, 0x64, 0x44, 0x80, 0xb3, 0x23, 0x48,
+	0xc6, 0xb5, 0x11, 0x23, 0xad, 0x46, 0x48, 0xd3, 0x23, 0x48, 0xf4, 0x24, 0x25, 0x56, 0x51, 0xdb,
+	0xcc, 0xc7, 0x6d, 0xf3, 0x0a, 0xd4, 0x8e, 0x71, 0xd7, 0xf1, 0xe4, 0x45, 0xf1, 0x34, 0xe5, 0x08,
+	0x6c, 0x7a, 0x3d, 0x60, 0x74, 0x09, 0xf6, 0xda, 0xb5, 0x52, 0xdb, 0x38, 0x72, 0xa2, 0x3c, 0x2d,
+	0xcc, 0x32, 0xe8, 0xdb, 0x0e, 0xb7, 0x1e, 0x4f, 0x44, 0x42, 0x96, 0xaf, 0x6f, 0x4d, 0xb4, 0x43,
+	0xd8, 0x48, 0x28, 0x2d, 0xf0, 0x81, 0x9b, 0xf1, 0x83, 0xc8, 0x4e, 0x16, 0xab, 0x11, 0x45, 0xcb,
+	0x03, 0xc8, 0x9f, 0x44, 0xed, 0xde, 0x1f, 0x3a, 0x03, 0xa3, 0x3f, 0xc9, 0x9c, 0xe5, 0xca, 0xdb,
+	0x28, 0x35, 0x72, 0x1b, 0x75, 0x0e, 0x1a, 0x1e, 0xee, 0x62, 0x4a, 0x56, 0x16, 0xc3, 0x10, 0x80,
+	0x2e, 0x42, 0x2b, 0x58, 0x44, 0xbb, 0x8c, 0xa5, 0x00, 0xaa, 0x0b, 0x22, 0x16, 0x1e, 0xdb, 0x46,
+	0x50, 0x13, 0x15, 0x3d, 0x04, 0xd0, 0xb7, 0xe4, 0x81, 0x87, 0xfd, 0x07, 0x4e, 0xdf, 0x12, 0xe9,
+	0x35, 0x04, 0x68, 0x3f, 0xad, 0xc0, 0xf2, 0x07, 0x23, 0xc3, 0x33, 0x68, 0x58, 0x63, 0x4b, 0x67,
+	0xb5, 0x26, 0xe9, 0x3d, 0x79, 0x87, 0x8d, 0xc2, 0x52, 0x1e, 0x14, 0xb7, 0xf9, 0xe9, 0xc5, 0xed,
+	0x26, 0x34, 0x0c, 0xa6, 0x39, 0x1a, 0x78, 0x55, 0x86, 0xbf, 0x91, 0xc4, 0x17, 0xaa, 0xd5, 0x43,
+	0x4c, 0xc6, 0x17, 0x31, 0xc8, 0xc8, 0x17, 0xed, 0x91, 0x58, 0x3d, 0x8e, 0xe7, 0xec, 0x40, 0xd3,
+	0xc3, 0x63, 0x1b, 0x3f, 0x8a, 0xba, 0x0e, 0x48, 0xd0, 0xad, 0x09, 0xfa, 0xff, 0x08, 0x82, 0x41,
+	0x4a, 0xdc, 0x95, 0x04, 0x9b, 0xf7, 0x09, 0x0d, 0x2d, 0x3a, 0xb6, 0xc5, 0x43, 0x9e, 0xd9, 0x1a,
+	0xba, 0x5c, 0x6a, 0x8f, 0x60, 0x23, 0x69, 0x06, 0x99, 0x16, 0x42, 0x29, 0x95, 0x98, 0x94, 0x79,
+	0x56, 0xa1, 0x09, 0xdd, 0x1e, 0xd8, 0xbc, 0xb3, 0xa8, 0xea, 0x7c, 0x41, 0xb1, 0x9d, 0x6e, 0xd7,
+	0xc7, 0x3c, 0xf7, 0x57, 0x75, 0xb1, 0xd2, 0xbe, 0x05, 0xed, 0xf4, 0x87, 0x4b, 0xb4, 0x0f, 0xa9,
+	0x4d, 0x22, 0x12, 0x8e, 0x61, 0x3b, 0x4d, 0x8f, 0x2a, 0x40, 0x8a, 0x93, 0x74, 0xae, 0xbc, 0x3b,
+	0xc7, 0xa8, 0xb2, 0x2a, 0x71, 0x65, 0xfd, 0x46, 0x01, 0x74, 0x68, 0x4c, 0xfc, 0x91, 0x8b, 0xa3,
+	0x37, 0x03, 0x65, 0xee, 0xca, 0x83, 0xd2, 0x5b, 0x89, 0x5e, 0xcb, 0x9e, 0xfc, 0x24, 0x9c, 0x48,
+	0x35, 0xd5, 0x64, 0xaa, 0xb9, 0x0a, 0x67, 0xd3, 0x9c, 0x16, 0x5c, 0xc1, 0xd2, 0x9b, 0xd6, 0xf4,
+	0x86, 0x72, 0x37, 0xad, 0x19, 0x1f, 0x12, 0x46, 0xf9, 0x9b, 0x02, 0x9b, 0xf4, 0x9c, 0x14, 0x9f,
+	0x37, 0xfb, 0x4f, 0x62, 0x2c, 0x9e, 0x68, 0xbe, 0x2b, 0xa9, 0xe6, 0x7b, 0x1d, 0x6a, 0xfc, 0xea,
+	0x42, 0x1c, 0x42, 0xc4, 0x2a, 0x74, 0xdb, 0x6a, 0xb6, 0xdb, 0xd6, 0x62, 0x6e, 0x6b, 0xc0, 0x46,
+	0x4a, 0x18, 0xa1, 0xa2, 0xe0, 0xcc, 0xa8, 0x44, 0xce, 0x8c, 0xf4, 0x3a, 0x83, 0x2b, 0x4e, 0x2d,
+	0xba, 0xce, 0xa0, 0x94, 0xa4, 0xd2, 0x5e, 0x82, 0x2d, 0x1d, 0x8f, 0xb1, 0x97, 0x33, 0xa5, 0x4f,
+	0x78, 0xdb, 0xf5, 0x2f, 0xb7, 0x61, 0x35, 0xa8, 0xe8, 0xd4, 0xed, 0x8f, 0xb0, 0x37, 0xb6, 0x4d,
+	0x8c, 0x3e, 0x86, 0xd5, 0xac, 0x5f, 0x0c, 0xa2, 0x58, 0x93, 0x5b, 0xf0, 0x9b, 0xc2, 0xcd, 0xcc,
+	0xd4, 0xa9, 0xcd, 0xa1, 0x0f, 0x61, 0x25, 0xe3, 0x27, 0x7e, 0xe8, 0xff, 0x32, 0xe8, 0x66, 0xcc,
+	0x65, 0x73, 0xc9, 0x1a, 0x70, 0x36, 0xf7, 0xa7, 0x75, 0xe8, 0xc5, 0x7c, 0xa6, 0xd3, 0x03, 0xcd,
+	0xdc, 0x4f, 0x74, 0xa0, 0x9d, 0xf7, 0x7b, 0x37, 0xf4, 0x42, 0x2e, 0xfb, 0x33, 0x7c, 0x60, 0x92,
+	0x1e, 0x38, 0xc4, 0x95, 0x7f, 0xad, 0x68, 0x04, 0x90, 0x69, 0x85, 0x52, 0x43, 0x03, 0x6d, 0x0e,
+	0xfd, 0x44, 0x01, 0x6d, 0xfa, 0x55, 0x01, 0xba, 0x59, 0x82, 0x81, 0x0c, 0x81, 0xcb, 0x72, 0xf1,
+	0x08, 0xce, 0x15, 0xcd, 0xe6, 0xd1, 0xd5, 0xc2, 0x09, 0x4b, 0x86, 0xb7, 0x94, 0xfd, 0xf0, 0x8f,
+	0x14, 0x78, 0x76, 0xea, 0xc0, 0x1b, 0xdd, 0x98, 0xfe, 0xf9, 0xc7, 0x10, 0xfe, 0x1e, 0x2c, 0x09,
+	0xc7, 0xe1, 0x53, 0x2c, 0xd4, 0x4e, 0x0d, 0xef, 0x24, 0xc9, 0xf3, 0xb9, 0x23, 0x31, 0x49, 0xec,
+	0x33, 0x58, 0xcb, 0x9c, 0x2d, 0x15, 0x10, 0xbd, 0x52, 0x66, 0x7c, 0x25, 0xe9, 0x1f, 0x42, 0x2b,
+	0x3e, 0x48, 0x42, 0xcf, 0x46, 0xb7, 0x67, 0x0e, 0x99, 0x36, 0x53, 0xa7, 0x23, 0x31, 0x34, 0xd2,
+	0xe6, 0xae, 0x29, 0xe8, 0x08, 0x5a, 0x42, 0x7c, 0x71, 0x52, 0x45, 0xe7, 0x73, 0x66, 0x05, 0x92,
+	0xdc, 0x4e, 0xee, 0xfb, 0x80, 0x4d, 0x8b, 0xfd, 0xee, 0x59, 0xf8, 0x04, 0xf3, 0x8e, 0xd8, 0xcf,
+	0x09, 0x2e, 0x4d, 0xbb, 0x6b, 0x96, 0x9f, 0x2a, 0x48, 0xbc, 0xda, 0x1c, 0x7a, 0x00, 0xdb, 0x77,
+	0x30, 0x09, 0xec, 0x9e, 0xfe, 0x4e, 0x4c, 0xb5, 0x85, 0xd7, 0xa8, 0x53, 0xbe, 0xf4, 0x09, 0x6c,
+	0xed, 0x5b, 0x56, 0xae, 0x3c, 0xbb, 0xd3, 0xe4, 0xd9, 0x3c, 0x1b, 0x33, 0x72, 0xec, 0x27, 0x62,
+	0x73, 0xe8, 0xdb, 0xb0, 0xbd, 0x6f, 0x59, 0x05, 0x72, 0x14, 0x30, 0x57, 0x4c, 0xd9, 0x82, 0x95,
+	0x8c, 0x4a, 0x1e, 0x4f, 0xfa, 0xf9, 0xa5, 0x7e, 0xf3, 0x42, 0xfe, 0x77, 0xfd, 0xc8, 0x57, 0xba,
+	0x70, 0x8e, 0xf7, 0xa8, 0xd9, 0x2a, 0x9e, 0xc5, 0x0c, 0x85, 0xd2, 0x7c, 0x02, 0xab, 0x59, 0x35,
+	0x36, 0xee, 0x4e, 0x05, 0x55, 0xb8, 0x98, 0xfa, 0x21, 0xac, 0xdf, 0xc1, 0xe4, 0x68, 0xe4, 0xba,
+	0x8e, 0x47, 0xb0, 0x15, 0x76, 0xff, 0xa8, 0x9d, 0xb1, 0x2d, 0xcb, 0x6b, 0x62, 0x53, 0x26, 0x6d,
+	0x0e, 0x7d, 0x00, 0x1b, 0x94, 0x62, 0x30, 0x65, 0x39, 0x05, 0x92, 0xef, 0x01, 0xba, 0x83, 0xc9,
+	0xa1, 0x67, 0x9b, 0xf8, 0x14, 0xa8, 0xbd, 0x0b, 0xcb, 0x77, 0x30, 0xf9, 0xc8, 0x20, 0xa7, 0x26,
+	0xec, 0x7e, 0x30, 0xf4, 0x39, 0x05, 0x92, 0x9f, 0x32, 0x8b, 0x64, 0x0c, 0xd4, 0x0a, 0x28, 0x5e,
+	0x2a, 0x39, 0x8b, 0x63, 0xb9, 0x7a, 0x23, 0x46, 0xfe, 0xfd, 0xc8, 0xc4, 0xe9, 0x34, 0xe8, 0x7f,
+	0xc4, 0x0a, 0x4b, 0x29, 0x3d, 0x5c, 0xc9, 0xa6, 0x9a, 0x31, 0x77, 0x62, 0x56, 0x6b, 0x1e, 0x05,
+	0x74, 0x13, 0xe9, 0x3a, 0x3d, 0x20, 0x99, 0xe6, 0xf4, 0xad, 0xf8, 0x78, 0x29, 0x5e, 0x4f, 0x32,
+	0x47, 0x4f, 0xd3, 0x92, 0x19, 0x0a, 0xa5, 0x96, 0xd3, 0x0f, 0xb4, 0x95, 0xc5, 0x64, 0x76, 0x9a,
+	0xc9, 0x9e, 0xb5, 0x04, 0xee, 0x90, 0xd1, 0xef, 0x94, 0x35, 0x57, 0x41, 0xab, 0xa4, 0xcd, 0xa1,
+	0xfb, 0xb0, 0x76, 0x94, 0x45, 0x1e, 0x4d, 0x69, 0x9b, 0x8a, 0xd5, 0xe1, 0xc2, 0xb9, 0x4c, 0xa6,
+	0xa5, 0x62, 0x2e, 0x16, 0x13, 0x3f, 0x81, 0x1c, 0xdf, 0x65, 0x1d, 0x44, 0x14, 0x87, 0xfd, 0xfc,
+	0xb6, 0xb4, 0x4f, 0xe7, 0xff, 0x72, 0x57, 0x9b, 0x43, 0x3a, 0xac, 0x1c, 0xa5, 0x89, 0xa3, 0x29,
+	0xbf, 0xe1, 0x9d, 0x96, 0xd6, 0xd7, 0xb9, 0x9f, 0xa5, 0xc8, 0x5e, 0x2c, 0x26, 0x5b, 0xca, 0x1f,
+	0x4d, 0x58, 0xa5, 0xe9, 0x24, 0x35, 0xb6, 0xba, 0x50, 0x38, 0x9f, 0xc8, 0x3a, 0x43, 0xe6, 0x4d,
+	0x3e, 0x58, 0x17, 0xb4, 0xb1, 0xef, 0xb2, 0x9b, 0xec, 0xd4, 0x77, 0xae, 0x14, 0x93, 0x88, 0x0c,
+	0x3b, 0x8a, 0xe5, 0xf8, 0x1e, 0xac, 0xeb, 0xf8, 0xfb, 0xd8, 0x24, 0x4f, 0xea, 0x0b, 0xb7, 0x96,
+	0xbf, 0xd3, 0x8a, 0xff, 0xf7, 0xdb, 0x71, 0x8d, 0xfd, 0x79, 0xf9, 0xbf, 0x03, 0x00, 0xf5, 0x5d,
+	0xe5, 0xc1, 0x16, 0x37, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CurrencyRatesServiceClient is the client API for CurrencyRatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CurrencyRatesServiceClient interface {
+	GetRateCurrentCommon(ctx context.Context, in *GetRateCurrentCommonRequest, opts ...grpc.CallOption) (*RateData, error)
+	GetRateByDateCommon(ctx context.Context, in *GetRateByDateCommonRequest, opts ...grpc.CallOption) (*RateData, error)
+	GetRateCurrentForMerchant(ctx context.Context, in *GetRateCurrentForMerchantRequest, opts ...grpc.CallOption) (*RateData, error)
+	GetRateByDateForMerchant(ctx context.Context, in *GetRateByDateForMerchantRequest, opts ...grpc.CallOption) (*RateData, error)
+	ExchangeCurrencyCurrentCommon(ctx context.Context, in *ExchangeCurrencyCurrentCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyCurrentForMerchant(ctx context.Context, in *ExchangeCurrencyCurrentForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyByDateCommon(ctx context.Context, in *ExchangeCurrencyByDateCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyByDateForMerchant(ctx context.Context, in *ExchangeCurrencyByDateForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error)
+	GetRatesBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*RatesBatchResponse, error)
+	ExchangeCurrencyBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*ExchangeCurrencyBatchResponse, error)
+	SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (CurrencyRatesService_SubscribeRatesClient, error)
+	GetRateHistory(ctx context.Context, in *RateHistoryRequest, opts ...grpc.CallOption) (*RateHistoryResponse, error)
+	GetCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error)
+	GetMerchantRateCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error)
+	AddCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	AddMerchantRateCorrectionRule(ctx context.Context, in *CorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListCorrectionRules(ctx context.Context, in *ListCorrectionRulesRequest, opts ...grpc.CallOption) (*CorrectionRulesResponse, error)
+	DeleteMerchantCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	RevertCorrectionRule(ctx context.Context, in *RevertCorrectionRuleRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetSupportedCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
+	GetSettlementCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
+	GetPriceCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
+	GetVatCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
+	GetAccountingCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error)
+	GetCurrenciesPrecision(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error)
+	GetCurrenciesMinorUnits(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error)
+	GetCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesDefinitionsResponse, error)
+	SetCurrency(ctx context.Context, in *CurrencyDefinition, opts ...grpc.CallOption) (*EmptyResponse, error)
+	DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetCurrencyHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*CurrencyHistoryResponse, error)
+	GetPaysuperCorrections(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PaysuperCorrectionsResponse, error)
+	SetPaysuperCorrection(ctx context.Context, in *PaysuperCorrection, opts ...grpc.CallOption) (*EmptyResponse, error)
+	GetPaysuperCorrectionHistory(ctx context.Context, in *PaysuperCorrectionRequest, opts ...grpc.CallOption) (*PaysuperCorrectionsResponse, error)
+	GetPaysuperCorridors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CorrectionCorridorsResponse, error)
+	SetPaysuperCorridor(ctx context.Context, in *CorrectionCorridor, opts ...grpc.CallOption) (*EmptyResponse, error)
+	DeletePaysuperCorridor(ctx context.Context, in *CorrectionCorridorRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	ListQuarantinedRates(ctx context.Context, in *QuarantinedRatesRequest, opts ...grpc.CallOption) (*QuarantinedRatesResponse, error)
+	ApproveQuarantinedRates(ctx context.Context, in *QuarantinedRatesReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+	RejectQuarantinedRates(ctx context.Context, in *QuarantinedRatesReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error)
+}
+
+type currencyRatesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCurrencyRatesServiceClient(cc *grpc.ClientConn) CurrencyRatesServiceClient {
+	return &currencyRatesServiceClient{cc}
+}
+
+func (c *currencyRatesServiceClient) GetRateCurrentCommon(ctx context.Context, in *GetRateCurrentCommonRequest, opts ...grpc.CallOption) (*RateData, error) {
+	out := new(RateData)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateCurrentCommon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetRateByDateCommon(ctx context.Context, in *GetRateByDateCommonRequest, opts ...grpc.CallOption) (*RateData, error) {
+	out := new(RateData)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateByDateCommon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetRateCurrentForMerchant(ctx context.Context, in *GetRateCurrentForMerchantRequest, opts ...grpc.CallOption) (*RateData, error) {
+	out := new(RateData)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateCurrentForMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetRateByDateForMerchant(ctx context.Context, in *GetRateByDateForMerchantRequest, opts ...grpc.CallOption) (*RateData, error) {
+	out := new(RateData)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateByDateForMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ExchangeCurrencyCurrentCommon(ctx context.Context, in *ExchangeCurrencyCurrentCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentCommon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ExchangeCurrencyCurrentForMerchant(ctx context.Context, in *ExchangeCurrencyCurrentForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentForMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ExchangeCurrencyByDateCommon(ctx context.Context, in *ExchangeCurrencyByDateCommonRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyByDateCommon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ExchangeCurrencyByDateForMerchant(ctx context.Context, in *ExchangeCurrencyByDateForMerchantRequest, opts ...grpc.CallOption) (*ExchangeCurrencyResponse, error) {
+	out := new(ExchangeCurrencyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyByDateForMerchant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetRatesBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*RatesBatchResponse, error) {
+	out := new(RatesBatchResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRatesBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ExchangeCurrencyBatch(ctx context.Context, in *BatchRequest, opts ...grpc.CallOption) (*ExchangeCurrencyBatchResponse, error) {
+	out := new(ExchangeCurrencyBatchResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ExchangeCurrencyBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) SubscribeRates(ctx context.Context, in *SubscribeRatesRequest, opts ...grpc.CallOption) (CurrencyRatesService_SubscribeRatesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CurrencyRatesService_serviceDesc.Streams[0], "/currencies.CurrencyRatesService/SubscribeRates", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &currencyRatesServiceSubscribeRatesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CurrencyRatesService_SubscribeRatesClient interface {
+	Recv() (*RatesUpdate, error)
+	grpc.ClientStream
+}
+
+type currencyRatesServiceSubscribeRatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *currencyRatesServiceSubscribeRatesClient) Recv() (*RatesUpdate, error) {
+	m := new(RatesUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *currencyRatesServiceClient) GetRateHistory(ctx context.Context, in *RateHistoryRequest, opts ...grpc.CallOption) (*RateHistoryResponse, error) {
+	out := new(RateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error) {
+	out := new(CorrectionRule)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCommonRateCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetMerchantRateCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*CorrectionRule, error) {
+	out := new(CorrectionRule)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetMerchantRateCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) AddCommonRateCorrectionRule(ctx context.Context, in *CommonCorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/AddCommonRateCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) AddMerchantRateCorrectionRule(ctx context.Context, in *CorrectionRule, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/AddMerchantRateCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ListCorrectionRules(ctx context.Context, in *ListCorrectionRulesRequest, opts ...grpc.CallOption) (*CorrectionRulesResponse, error) {
+	out := new(CorrectionRulesResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ListCorrectionRules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) DeleteMerchantCorrectionRule(ctx context.Context, in *MerchantCorrectionRuleRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/DeleteMerchantCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) RevertCorrectionRule(ctx context.Context, in *RevertCorrectionRuleRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/RevertCorrectionRule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetSupportedCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
+	out := new(CurrenciesList)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetSupportedCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetSettlementCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
+	out := new(CurrenciesList)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetSettlementCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetPriceCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
+	out := new(CurrenciesList)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetPriceCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetVatCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
+	out := new(CurrenciesList)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetVatCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetAccountingCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesList, error) {
+	out := new(CurrenciesList)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetAccountingCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetCurrenciesPrecision(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error) {
+	out := new(CurrenciesPrecisionResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCurrenciesPrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetCurrenciesMinorUnits(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesPrecisionResponse, error) {
+	out := new(CurrenciesPrecisionResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCurrenciesMinorUnits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetCurrencies(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CurrenciesDefinitionsResponse, error) {
+	out := new(CurrenciesDefinitionsResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCurrencies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) SetCurrency(ctx context.Context, in *CurrencyDefinition, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/SetCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) DeleteCurrency(ctx context.Context, in *DeleteCurrencyRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/DeleteCurrency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetCurrencyHistory(ctx context.Context, in *CurrencyRequest, opts ...grpc.CallOption) (*CurrencyHistoryResponse, error) {
+	out := new(CurrencyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetCurrencyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetPaysuperCorrections(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*PaysuperCorrectionsResponse, error) {
+	out := new(PaysuperCorrectionsResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetPaysuperCorrections", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) SetPaysuperCorrection(ctx context.Context, in *PaysuperCorrection, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/SetPaysuperCorrection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetPaysuperCorrectionHistory(ctx context.Context, in *PaysuperCorrectionRequest, opts ...grpc.CallOption) (*PaysuperCorrectionsResponse, error) {
+	out := new(PaysuperCorrectionsResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetPaysuperCorrectionHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) GetPaysuperCorridors(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*CorrectionCorridorsResponse, error) {
+	out := new(CorrectionCorridorsResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/GetPaysuperCorridors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) SetPaysuperCorridor(ctx context.Context, in *CorrectionCorridor, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/SetPaysuperCorridor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) DeletePaysuperCorridor(ctx context.Context, in *CorrectionCorridorRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/DeletePaysuperCorridor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ListQuarantinedRates(ctx context.Context, in *QuarantinedRatesRequest, opts ...grpc.CallOption) (*QuarantinedRatesResponse, error) {
+	out := new(QuarantinedRatesResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ListQuarantinedRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) ApproveQuarantinedRates(ctx context.Context, in *QuarantinedRatesReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/ApproveQuarantinedRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *currencyRatesServiceClient) RejectQuarantinedRates(ctx context.Context, in *QuarantinedRatesReviewRequest, opts ...grpc.CallOption) (*EmptyResponse, error) {
+	out := new(EmptyResponse)
+	err := c.cc.Invoke(ctx, "/currencies.CurrencyRatesService/RejectQuarantinedRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CurrencyRatesServiceServer is the server API for CurrencyRatesService service.
+type CurrencyRatesServiceServer interface {
+	GetRateCurrentCommon(context.Context, *GetRateCurrentCommonRequest) (*RateData, error)
+	GetRateByDateCommon(context.Context, *GetRateByDateCommonRequest) (*RateData, error)
+	GetRateCurrentForMerchant(context.Context, *GetRateCurrentForMerchantRequest) (*RateData, error)
+	GetRateByDateForMerchant(context.Context, *GetRateByDateForMerchantRequest) (*RateData, error)
+	ExchangeCurrencyCurrentCommon(context.Context, *ExchangeCurrencyCurrentCommonRequest) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyCurrentForMerchant(context.Context, *ExchangeCurrencyCurrentForMerchantRequest) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyByDateCommon(context.Context, *ExchangeCurrencyByDateCommonRequest) (*ExchangeCurrencyResponse, error)
+	ExchangeCurrencyByDateForMerchant(context.Context, *ExchangeCurrencyByDateForMerchantRequest) (*ExchangeCurrencyResponse, error)
+	GetRatesBatch(context.Context, *BatchRequest) (*RatesBatchResponse, error)
+	ExchangeCurrencyBatch(context.Context, *BatchRequest) (*ExchangeCurrencyBatchResponse, error)
+	SubscribeRates(*SubscribeRatesRequest, CurrencyRatesService_SubscribeRatesServer) error
+	GetRateHistory(context.Context, *RateHistoryRequest) (*RateHistoryResponse, error)
+	GetCommonRateCorrectionRule(context.Context, *CommonCorrectionRuleRequest) (*CorrectionRule, error)
+	GetMerchantRateCorrectionRule(context.Context, *MerchantCorrectionRuleRequest) (*CorrectionRule, error)
+	AddCommonRateCorrectionRule(context.Context, *CommonCorrectionRule) (*EmptyResponse, error)
+	AddMerchantRateCorrectionRule(context.Context, *CorrectionRule) (*EmptyResponse, error)
+	ListCorrectionRules(context.Context, *ListCorrectionRulesRequest) (*CorrectionRulesResponse, error)
+	DeleteMerchantCorrectionRule(context.Context, *MerchantCorrectionRuleRequest) (*EmptyResponse, error)
+	RevertCorrectionRule(context.Context, *RevertCorrectionRuleRequest) (*EmptyResponse, error)
+	GetSupportedCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
+	GetSettlementCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
+	GetPriceCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
+	GetVatCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
+	GetAccountingCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error)
+	GetCurrenciesPrecision(context.Context, *EmptyRequest) (*CurrenciesPrecisionResponse, error)
+	GetCurrenciesMinorUnits(context.Context, *EmptyRequest) (*CurrenciesPrecisionResponse, error)
+	GetCurrencies(context.Context, *EmptyRequest) (*CurrenciesDefinitionsResponse, error)
+	SetCurrency(context.Context, *CurrencyDefinition) (*EmptyResponse, error)
+	DeleteCurrency(context.Context, *DeleteCurrencyRequest) (*EmptyResponse, error)
+	GetCurrencyHistory(context.Context, *CurrencyRequest) (*CurrencyHistoryResponse, error)
+	GetPaysuperCorrections(context.Context, *EmptyRequest) (*PaysuperCorrectionsResponse, error)
+	SetPaysuperCorrection(context.Context, *PaysuperCorrection) (*EmptyResponse, error)
+	GetPaysuperCorrectionHistory(context.Context, *PaysuperCorrectionRequest) (*PaysuperCorrectionsResponse, error)
+	GetPaysuperCorridors(context.Context, *EmptyRequest) (*CorrectionCorridorsResponse, error)
+	SetPaysuperCorridor(context.Context, *CorrectionCorridor) (*EmptyResponse, error)
+	DeletePaysuperCorridor(context.Context, *CorrectionCorridorRequest) (*EmptyResponse, error)
+	ListQuarantinedRates(context.Context, *QuarantinedRatesRequest) (*QuarantinedRatesResponse, error)
+	ApproveQuarantinedRates(context.Context, *QuarantinedRatesReviewRequest) (*EmptyResponse, error)
+	RejectQuarantinedRates(context.Context, *QuarantinedRatesReviewRequest) (*EmptyResponse, error)
+}
+
+// UnimplementedCurrencyRatesServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCurrencyRatesServiceServer struct {
+}
+
+func (*UnimplementedCurrencyRatesServiceServer) GetRateCurrentCommon(ctx context.Context, req *GetRateCurrentCommonRequest) (*RateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateCurrentCommon not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetRateByDateCommon(ctx context.Context, req *GetRateByDateCommonRequest) (*RateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateByDateCommon not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetRateCurrentForMerchant(ctx context.Context, req *GetRateCurrentForMerchantRequest) (*RateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateCurrentForMerchant not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetRateByDateForMerchant(ctx context.Context, req *GetRateByDateForMerchantRequest) (*RateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateByDateForMerchant not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyCurrentCommon(ctx context.Context, req *ExchangeCurrencyCurrentCommonRequest) (*ExchangeCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyCurrentCommon not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyCurrentForMerchant(ctx context.Context, req *ExchangeCurrencyCurrentForMerchantRequest) (*ExchangeCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyCurrentForMerchant not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyByDateCommon(ctx context.Context, req *ExchangeCurrencyByDateCommonRequest) (*ExchangeCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyByDateCommon not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyByDateForMerchant(ctx context.Context, req *ExchangeCurrencyByDateForMerchantRequest) (*ExchangeCurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyByDateForMerchant not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetRatesBatch(ctx context.Context, req *BatchRequest) (*RatesBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRatesBatch not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ExchangeCurrencyBatch(ctx context.Context, req *BatchRequest) (*ExchangeCurrencyBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeCurrencyBatch not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) SubscribeRates(req *SubscribeRatesRequest, srv CurrencyRatesService_SubscribeRatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeRates not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetRateHistory(ctx context.Context, req *RateHistoryRequest) (*RateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRateHistory not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetCommonRateCorrectionRule(ctx context.Context, req *CommonCorrectionRuleRequest) (*CorrectionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommonRateCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetMerchantRateCorrectionRule(ctx context.Context, req *MerchantCorrectionRuleRequest) (*CorrectionRule, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMerchantRateCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) AddCommonRateCorrectionRule(ctx context.Context, req *CommonCorrectionRule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCommonRateCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) AddMerchantRateCorrectionRule(ctx context.Context, req *CorrectionRule) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMerchantRateCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ListCorrectionRules(ctx context.Context, req *ListCorrectionRulesRequest) (*CorrectionRulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCorrectionRules not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) DeleteMerchantCorrectionRule(ctx context.Context, req *MerchantCorrectionRuleRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMerchantCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) RevertCorrectionRule(ctx context.Context, req *RevertCorrectionRuleRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertCorrectionRule not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetSupportedCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSupportedCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetSettlementCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetPriceCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetVatCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVatCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetAccountingCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountingCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetCurrenciesPrecision(ctx context.Context, req *EmptyRequest) (*CurrenciesPrecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrenciesPrecision not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetCurrenciesMinorUnits(ctx context.Context, req *EmptyRequest) (*CurrenciesPrecisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrenciesMinorUnits not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetCurrencies(ctx context.Context, req *EmptyRequest) (*CurrenciesDefinitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencies not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) SetCurrency(ctx context.Context, req *CurrencyDefinition) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCurrency not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) DeleteCurrency(ctx context.Context, req *DeleteCurrencyRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCurrency not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetCurrencyHistory(ctx context.Context, req *CurrencyRequest) (*CurrencyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCurrencyHistory not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetPaysuperCorrections(ctx context.Context, req *EmptyRequest) (*PaysuperCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaysuperCorrections not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) SetPaysuperCorrection(ctx context.Context, req *PaysuperCorrection) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaysuperCorrection not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetPaysuperCorrectionHistory(ctx context.Context, req *PaysuperCorrectionRequest) (*PaysuperCorrectionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaysuperCorrectionHistory not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) GetPaysuperCorridors(ctx context.Context, req *EmptyRequest) (*CorrectionCorridorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaysuperCorridors not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) SetPaysuperCorridor(ctx context.Context, req *CorrectionCorridor) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPaysuperCorridor not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) DeletePaysuperCorridor(ctx context.Context, req *CorrectionCorridorRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePaysuperCorridor not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ListQuarantinedRates(ctx context.Context, req *QuarantinedRatesRequest) (*QuarantinedRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuarantinedRates not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) ApproveQuarantinedRates(ctx context.Context, req *QuarantinedRatesReviewRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveQuarantinedRates not implemented")
+}
+func (*UnimplementedCurrencyRatesServiceServer) RejectQuarantinedRates(ctx context.Context, req *QuarantinedRatesReviewRequest) (*EmptyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectQuarantinedRates not implemented")
+}
+
+func RegisterCurrencyRatesServiceServer(s *grpc.Server, srv CurrencyRatesServiceServer) {
+	s.RegisterService(&_CurrencyRatesService_serviceDesc, srv)
+}
+
+func _CurrencyRatesService_GetRateCurrentCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateCurrentCommonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRateCurrentCommon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRateCurrentCommon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRateCurrentCommon(ctx, req.(*GetRateCurrentCommonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetRateByDateCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateByDateCommonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRateByDateCommon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRateByDateCommon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRateByDateCommon(ctx, req.(*GetRateByDateCommonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetRateCurrentForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateCurrentForMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRateCurrentForMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRateCurrentForMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRateCurrentForMerchant(ctx, req.(*GetRateCurrentForMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetRateByDateForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRateByDateForMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRateByDateForMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRateByDateForMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRateByDateForMerchant(ctx, req.(*GetRateByDateForMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_ExchangeCurrencyCurrentCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyCurrentCommonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentCommon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentCommon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentCommon(ctx, req.(*ExchangeCurrencyCurrentCommonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_ExchangeCurrencyCurrentForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyCurrentForMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentForMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentForMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentForMerchant(ctx, req.(*ExchangeCurrencyCurrentForMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_ExchangeCurrencyByDateCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyByDateCommonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateCommon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyByDateCommon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateCommon(ctx, req.(*ExchangeCurrencyByDateCommonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_ExchangeCurrencyByDateForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeCurrencyByDateForMerchantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateForMerchant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyByDateForMerchant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateForMerchant(ctx, req.(*ExchangeCurrencyByDateForMerchantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetRatesBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRatesBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRatesBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRatesBatch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_ExchangeCurrencyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyBatch(ctx, req.(*BatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_SubscribeRates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRatesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CurrencyRatesServiceServer).SubscribeRates(m, &currencyRatesServiceSubscribeRatesServer{stream})
+}
+
+type CurrencyRatesService_SubscribeRatesServer interface {
+	Send(*RatesUpdate) error
+	grpc.ServerStream
+}
+
+type currencyRatesServiceSubscribeRatesServer struct {
+	grpc.ServerStream
+}
+
+func (x *currencyRatesServiceSubscribeRatesServer) Send(m *RatesUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CurrencyRatesService_GetRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetRateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetRateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetRateHistory(ctx, req.(*RateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetCommonRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonCorrectionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetCommonRateCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetCommonRateCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetCommonRateCorrectionRule(ctx, req.(*CommonCorrectionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_GetMerchantRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MerchantCorrectionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetMerchantRateCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetMerchantRateCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetMerchantRateCorrectionRule(ctx, req.(*MerchantCorrectionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CurrencyRatesService_AddCommonRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommonCorrectionRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).AddCommonRateCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/AddCommonRateCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).AddCommonRateCorrectionRule(ctx, req.(*CommonCorrectionRule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetSettlementCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSettlementCurrencies not implemented")
+
+func _CurrencyRatesService_AddMerchantRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectionRule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).AddMerchantRateCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/AddMerchantRateCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).AddMerchantRateCorrectionRule(ctx, req.(*CorrectionRule))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetPriceCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPriceCurrencies not implemented")
+
+func _CurrencyRatesService_ListCorrectionRules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCorrectionRulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).ListCorrectionRules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/ListCorrectionRules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).ListCorrectionRules(ctx, req.(*ListCorrectionRulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetVatCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVatCurrencies not implemented")
+
+func _CurrencyRatesService_DeleteMerchantCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MerchantCorrectionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).DeleteMerchantCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/DeleteMerchantCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).DeleteMerchantCorrectionRule(ctx, req.(*MerchantCorrectionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetAccountingCurrencies(context.Context, *EmptyRequest) (*CurrenciesList, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountingCurrencies not implemented")
+
+func _CurrencyRatesService_RevertCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertCorrectionRuleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).RevertCorrectionRule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/RevertCorrectionRule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).RevertCorrectionRule(ctx, req.(*RevertCorrectionRuleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedCurrencyRatesServiceServer) GetCurrenciesPrecision(context.Context, *EmptyRequest) (*CurrenciesPrecisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCurrenciesPrecision not implemented")
+
+func _CurrencyRatesService_GetSupportedCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetSupportedCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetSupportedCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetSupportedCurrencies(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterCurrencyRatesServiceServer(s *grpc.Server, srv CurrencyRatesServiceServer) {
-	s.RegisterService(&_CurrencyRatesService_serviceDesc, srv)
+func _CurrencyRatesService_GetSettlementCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CurrencyRatesServiceServer).GetSettlementCurrencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/currencies.CurrencyRatesService/GetSettlementCurrencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CurrencyRatesServiceServer).GetSettlementCurrencies(ctx, req.(*EmptyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetRateCurrentCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateCurrentCommonRequest)
+func _CurrencyRatesService_GetPriceCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetRateCurrentCommon(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetPriceCurrencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetRateCurrentCommon",
+		FullMethod: "/currencies.CurrencyRatesService/GetPriceCurrencies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetRateCurrentCommon(ctx, req.(*GetRateCurrentCommonRequest))
+		return srv.(CurrencyRatesServiceServer).GetPriceCurrencies(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetRateByDateCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateByDateCommonRequest)
+func _CurrencyRatesService_GetVatCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetRateByDateCommon(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetVatCurrencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetRateByDateCommon",
+		FullMethod: "/currencies.CurrencyRatesService/GetVatCurrencies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetRateByDateCommon(ctx, req.(*GetRateByDateCommonRequest))
+		return srv.(CurrencyRatesServiceServer).GetVatCurrencies(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetRateCurrentForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateCurrentForMerchantRequest)
+func _CurrencyRatesService_GetAccountingCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetRateCurrentForMerchant(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetAccountingCurrencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetRateCurrentForMerchant",
+		FullMethod: "/currencies.CurrencyRatesService/GetAccountingCurrencies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetRateCurrentForMerchant(ctx, req.(*GetRateCurrentForMerchantRequest))
+		return srv.(CurrencyRatesServiceServer).GetAccountingCurrencies(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetRateByDateForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRateByDateForMerchantRequest)
+func _CurrencyRatesService_GetCurrenciesPrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetRateByDateForMerchant(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetCurrenciesPrecision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetRateByDateForMerchant",
+		FullMethod: "/currencies.CurrencyRatesService/GetCurrenciesPrecision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetRateByDateForMerchant(ctx, req.(*GetRateByDateForMerchantRequest))
+		return srv.(CurrencyRatesServiceServer).GetCurrenciesPrecision(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_ExchangeCurrencyCurrentCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangeCurrencyCurrentCommonRequest)
+func _CurrencyRatesService_GetCurrenciesMinorUnits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentCommon(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetCurrenciesMinorUnits(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentCommon",
+		FullMethod: "/currencies.CurrencyRatesService/GetCurrenciesMinorUnits",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentCommon(ctx, req.(*ExchangeCurrencyCurrentCommonRequest))
+		return srv.(CurrencyRatesServiceServer).GetCurrenciesMinorUnits(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_ExchangeCurrencyCurrentForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangeCurrencyCurrentForMerchantRequest)
+func _CurrencyRatesService_GetCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentForMerchant(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetCurrencies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyCurrentForMerchant",
+		FullMethod: "/currencies.CurrencyRatesService/GetCurrencies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyCurrentForMerchant(ctx, req.(*ExchangeCurrencyCurrentForMerchantRequest))
+		return srv.(CurrencyRatesServiceServer).GetCurrencies(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_ExchangeCurrencyByDateCommon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangeCurrencyByDateCommonRequest)
+func _CurrencyRatesService_SetCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyDefinition)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateCommon(ctx, in)
+		return srv.(CurrencyRatesServiceServer).SetCurrency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyByDateCommon",
+		FullMethod: "/currencies.CurrencyRatesService/SetCurrency",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateCommon(ctx, req.(*ExchangeCurrencyByDateCommonRequest))
+		return srv.(CurrencyRatesServiceServer).SetCurrency(ctx, req.(*CurrencyDefinition))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_ExchangeCurrencyByDateForMerchant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangeCurrencyByDateForMerchantRequest)
+func _CurrencyRatesService_DeleteCurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateForMerchant(ctx, in)
+		return srv.(CurrencyRatesServiceServer).DeleteCurrency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/ExchangeCurrencyByDateForMerchant",
+		FullMethod: "/currencies.CurrencyRatesService/DeleteCurrency",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).ExchangeCurrencyByDateForMerchant(ctx, req.(*ExchangeCurrencyByDateForMerchantRequest))
+		return srv.(CurrencyRatesServiceServer).DeleteCurrency(ctx, req.(*DeleteCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetCommonRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommonCorrectionRuleRequest)
+func _CurrencyRatesService_GetCurrencyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetCommonRateCorrectionRule(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetCurrencyHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetCommonRateCorrectionRule",
+		FullMethod: "/currencies.CurrencyRatesService/GetCurrencyHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetCommonRateCorrectionRule(ctx, req.(*CommonCorrectionRuleRequest))
+		return srv.(CurrencyRatesServiceServer).GetCurrencyHistory(ctx, req.(*CurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetMerchantRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MerchantCorrectionRuleRequest)
+func _CurrencyRatesService_GetPaysuperCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetMerchantRateCorrectionRule(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorrections(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetMerchantRateCorrectionRule",
+		FullMethod: "/currencies.CurrencyRatesService/GetPaysuperCorrections",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetMerchantRateCorrectionRule(ctx, req.(*MerchantCorrectionRuleRequest))
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorrections(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_AddCommonRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CommonCorrectionRule)
+func _CurrencyRatesService_SetPaysuperCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaysuperCorrection)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).AddCommonRateCorrectionRule(ctx, in)
+		return srv.(CurrencyRatesServiceServer).SetPaysuperCorrection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/AddCommonRateCorrectionRule",
+		FullMethod: "/currencies.CurrencyRatesService/SetPaysuperCorrection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).AddCommonRateCorrectionRule(ctx, req.(*CommonCorrectionRule))
+		return srv.(CurrencyRatesServiceServer).SetPaysuperCorrection(ctx, req.(*PaysuperCorrection))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_AddMerchantRateCorrectionRule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CorrectionRule)
+func _CurrencyRatesService_GetPaysuperCorrectionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PaysuperCorrectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).AddMerchantRateCorrectionRule(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorrectionHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/AddMerchantRateCorrectionRule",
+		FullMethod: "/currencies.CurrencyRatesService/GetPaysuperCorrectionHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).AddMerchantRateCorrectionRule(ctx, req.(*CorrectionRule))
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorrectionHistory(ctx, req.(*PaysuperCorrectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetSupportedCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _CurrencyRatesService_GetPaysuperCorridors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetSupportedCurrencies(ctx, in)
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorridors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetSupportedCurrencies",
+		FullMethod: "/currencies.CurrencyRatesService/GetPaysuperCorridors",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetSupportedCurrencies(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).GetPaysuperCorridors(ctx, req.(*EmptyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetSettlementCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _CurrencyRatesService_SetPaysuperCorridor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectionCorridor)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetSettlementCurrencies(ctx, in)
+		return srv.(CurrencyRatesServiceServer).SetPaysuperCorridor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetSettlementCurrencies",
+		FullMethod: "/currencies.CurrencyRatesService/SetPaysuperCorridor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetSettlementCurrencies(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).SetPaysuperCorridor(ctx, req.(*CorrectionCorridor))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetPriceCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _CurrencyRatesService_DeletePaysuperCorridor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CorrectionCorridorRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetPriceCurrencies(ctx, in)
+		return srv.(CurrencyRatesServiceServer).DeletePaysuperCorridor(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetPriceCurrencies",
+		FullMethod: "/currencies.CurrencyRatesService/DeletePaysuperCorridor",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetPriceCurrencies(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).DeletePaysuperCorridor(ctx, req.(*CorrectionCorridorRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetVatCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _CurrencyRatesService_ListQuarantinedRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedRatesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetVatCurrencies(ctx, in)
+		return srv.(CurrencyRatesServiceServer).ListQuarantinedRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetVatCurrencies",
+		FullMethod: "/currencies.CurrencyRatesService/ListQuarantinedRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetVatCurrencies(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).ListQuarantinedRates(ctx, req.(*QuarantinedRatesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetAccountingCurrencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _CurrencyRatesService_ApproveQuarantinedRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedRatesReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetAccountingCurrencies(ctx, in)
+		return srv.(CurrencyRatesServiceServer).ApproveQuarantinedRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetAccountingCurrencies",
+		FullMethod: "/currencies.CurrencyRatesService/ApproveQuarantinedRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetAccountingCurrencies(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).ApproveQuarantinedRates(ctx, req.(*QuarantinedRatesReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CurrencyRatesService_GetCurrenciesPrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyRequest)
+func _CurrencyRatesService_RejectQuarantinedRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuarantinedRatesReviewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CurrencyRatesServiceServer).GetCurrenciesPrecision(ctx, in)
+		return srv.(CurrencyRatesServiceServer).RejectQuarantinedRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/currencies.CurrencyRatesService/GetCurrenciesPrecision",
+		FullMethod: "/currencies.CurrencyRatesService/RejectQuarantinedRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CurrencyRatesServiceServer).GetCurrenciesPrecision(ctx, req.(*EmptyRequest))
+		return srv.(CurrencyRatesServiceServer).RejectQuarantinedRates(ctx, req.(*QuarantinedRatesReviewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2982,6 +5570,18 @@ var _CurrencyRatesService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExchangeCurrencyByDateForMerchant",
 			Handler:    _CurrencyRatesService_ExchangeCurrencyByDateForMerchant_Handler,
 		},
+		{
+			MethodName: "GetRatesBatch",
+			Handler:    _CurrencyRatesService_GetRatesBatch_Handler,
+		},
+		{
+			MethodName: "ExchangeCurrencyBatch",
+			Handler:    _CurrencyRatesService_ExchangeCurrencyBatch_Handler,
+		},
+		{
+			MethodName: "GetRateHistory",
+			Handler:    _CurrencyRatesService_GetRateHistory_Handler,
+		},
 		{
 			MethodName: "GetCommonRateCorrectionRule",
 			Handler:    _CurrencyRatesService_GetCommonRateCorrectionRule_Handler,
@@ -2998,6 +5598,18 @@ var _CurrencyRatesService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddMerchantRateCorrectionRule",
 			Handler:    _CurrencyRatesService_AddMerchantRateCorrectionRule_Handler,
 		},
+		{
+			MethodName: "ListCorrectionRules",
+			Handler:    _CurrencyRatesService_ListCorrectionRules_Handler,
+		},
+		{
+			MethodName: "DeleteMerchantCorrectionRule",
+			Handler:    _CurrencyRatesService_DeleteMerchantCorrectionRule_Handler,
+		},
+		{
+			MethodName: "RevertCorrectionRule",
+			Handler:    _CurrencyRatesService_RevertCorrectionRule_Handler,
+		},
 		{
 			MethodName: "GetSupportedCurrencies",
 			Handler:    _CurrencyRatesService_GetSupportedCurrencies_Handler,
@@ -3022,7 +5634,69 @@ var _CurrencyRatesService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCurrenciesPrecision",
 			Handler:    _CurrencyRatesService_GetCurrenciesPrecision_Handler,
 		},
+		{
+			MethodName: "GetCurrenciesMinorUnits",
+			Handler:    _CurrencyRatesService_GetCurrenciesMinorUnits_Handler,
+		},
+		{
+			MethodName: "GetCurrencies",
+			Handler:    _CurrencyRatesService_GetCurrencies_Handler,
+		},
+		{
+			MethodName: "SetCurrency",
+			Handler:    _CurrencyRatesService_SetCurrency_Handler,
+		},
+		{
+			MethodName: "DeleteCurrency",
+			Handler:    _CurrencyRatesService_DeleteCurrency_Handler,
+		},
+		{
+			MethodName: "GetCurrencyHistory",
+			Handler:    _CurrencyRatesService_GetCurrencyHistory_Handler,
+		},
+		{
+			MethodName: "GetPaysuperCorrections",
+			Handler:    _CurrencyRatesService_GetPaysuperCorrections_Handler,
+		},
+		{
+			MethodName: "SetPaysuperCorrection",
+			Handler:    _CurrencyRatesService_SetPaysuperCorrection_Handler,
+		},
+		{
+			MethodName: "GetPaysuperCorrectionHistory",
+			Handler:    _CurrencyRatesService_GetPaysuperCorrectionHistory_Handler,
+		},
+		{
+			MethodName: "GetPaysuperCorridors",
+			Handler:    _CurrencyRatesService_GetPaysuperCorridors_Handler,
+		},
+		{
+			MethodName: "SetPaysuperCorridor",
+			Handler:    _CurrencyRatesService_SetPaysuperCorridor_Handler,
+		},
+		{
+			MethodName: "DeletePaysuperCorridor",
+			Handler:    _CurrencyRatesService_DeletePaysuperCorridor_Handler,
+		},
+		{
+			MethodName: "ListQuarantinedRates",
+			Handler:    _CurrencyRatesService_ListQuarantinedRates_Handler,
+		},
+		{
+			MethodName: "ApproveQuarantinedRates",
+			Handler:    _CurrencyRatesService_ApproveQuarantinedRates_Handler,
+		},
+		{
+			MethodName: "RejectQuarantinedRates",
+			Handler:    _CurrencyRatesService_RejectQuarantinedRates_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeRates",
+			Handler:       _CurrencyRatesService_SubscribeRates_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/grpc/proto/currencies.proto",
 }